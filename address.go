@@ -0,0 +1,130 @@
+package rrgo
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Address is an Ethereum address. It is marshalled to JSON, text and SQL
+// as lowercase 0x-prefixed hex, and parsed from hex of any case.
+type Address [20]byte
+
+// HexToAddress parses a hex address, with or without the 0x prefix and in
+// any case. The checksum of mixed-case addresses is not checked.
+func HexToAddress(s string) (Address, error) {
+	a := Address{}
+	h := s
+	if strings.HasPrefix(h, "0x") || strings.HasPrefix(h, "0X") {
+		h = h[2:]
+	}
+	if len(h) != 2*len(a) {
+		return a, fmt.Errorf("address %q must be %d bytes long", s, len(a))
+	}
+	if _, err := hex.Decode(a[:], []byte(h)); err != nil {
+		return a, fmt.Errorf("address %q is not hex", s)
+	}
+	return a, nil
+}
+
+// MustHexToAddress is HexToAddress panicking on malformed addresses, for
+// constants.
+func MustHexToAddress(s string) Address {
+	a, err := HexToAddress(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Hex returns the address in the EIP-55 mixed-case checksum encoding.
+func (a Address) Hex() string {
+	lower := hex.EncodeToString(a[:])
+	hash := hex.EncodeToString(crypto.Keccak256([]byte(lower)))
+	cs := []byte(lower)
+	for i, c := range cs {
+		if c >= 'a' && c <= 'f' && hash[i] >= '8' {
+			cs[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(cs)
+}
+
+// String returns the EIP-55 checksum encoding, see Hex.
+func (a Address) String() string {
+	return a.Hex()
+}
+
+// lower returns the lowercase 0x-prefixed hex of the address.
+func (a Address) lower() string {
+	return "0x" + hex.EncodeToString(a[:])
+}
+
+// IsZero reports whether a is the zero address.
+func (a Address) IsZero() bool {
+	return a == Address{}
+}
+
+func (a Address) MarshalText() ([]byte, error) {
+	return []byte(a.lower()), nil
+}
+
+func (a *Address) UnmarshalText(b []byte) error {
+	addr, err := HexToAddress(string(b))
+	if err != nil {
+		return err
+	}
+	*a = addr
+	return nil
+}
+
+func (a Address) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.lower())
+}
+
+// UnmarshalJSON parses a JSON string. Like encoding/json does for other
+// types, null leaves the address unchanged.
+func (a *Address) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s := ""
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("address must be a JSON string: %s", err)
+	}
+	return a.UnmarshalText([]byte(s))
+}
+
+// Value stores the address as lowercase hex.
+func (a Address) Value() (driver.Value, error) {
+	return a.lower(), nil
+}
+
+// Scan reads an address stored as hex text, or as 20 raw bytes.
+func (a *Address) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		if len(v) == len(a) {
+			copy(a[:], v)
+			return nil
+		}
+		return a.UnmarshalText(v)
+	case string:
+		return a.UnmarshalText([]byte(v))
+	default:
+		return fmt.Errorf("can't scan %T into an Address", src)
+	}
+}
+
+// EncodeValues adds the address to URL query values, unless it's zero.
+func (a Address) EncodeValues(key string, v *url.Values) error {
+	if !a.IsZero() {
+		v.Add(key, a.lower())
+	}
+	return nil
+}
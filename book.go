@@ -0,0 +1,225 @@
+package rrgo
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type bookEntry struct {
+	order   APIOrder
+	bo      *BookOrder
+	expires int64
+}
+
+// LocalBook is an in-memory order book of one token pair, kept up to date
+// from an orderbook snapshot and subsequent order updates. It is safe for
+// concurrent use.
+type LocalBook struct {
+	mu               sync.RWMutex
+	baseTokenAddress string
+	// bids and asks are keyed by order hash
+	bids map[string]*bookEntry
+	asks map[string]*bookEntry
+	// tokens names and scales the tokens of the orders
+	tokens *TokenRegistry
+	now    func() time.Time
+}
+
+func NewLocalBook(baseTokenAddress string) *LocalBook {
+	return NewLocalBookWithTokens(baseTokenAddress, DefaultTokens)
+}
+
+// NewLocalBookWithTokens returns a LocalBook looking up the tokens of its
+// orders in tokens instead of DefaultTokens.
+func NewLocalBookWithTokens(baseTokenAddress string, tokens *TokenRegistry) *LocalBook {
+	return &LocalBook{
+		baseTokenAddress: strings.ToLower(baseTokenAddress),
+		tokens:           tokens,
+		bids:             map[string]*bookEntry{},
+		asks:             map[string]*bookEntry{},
+		now:              time.Now,
+	}
+}
+
+// side returns Ask for orders selling the base token, Bid otherwise.
+// Addresses are compared ignoring case, so checksummed ones match too.
+func (b *LocalBook) side(o *APIOrder) string {
+	if strings.EqualFold(o.MakerToken, b.baseTokenAddress) {
+		return "Ask"
+	}
+	return "Bid"
+}
+
+func newBookEntry(o APIOrder, bidask string, tokens *TokenRegistry) (string, *bookEntry, error) {
+	order, err := o.ToOrder()
+	if err != nil {
+		return "", nil, err
+	}
+	bo, err := o.ProcessWith(tokens, bidask)
+	if err != nil {
+		return "", nil, err
+	}
+	bo.Hash = order.HashHex()
+	exp := order.ExpirationTimestampInSec.Big()
+	if !exp.IsInt64() {
+		exp.SetInt64(1<<63 - 1)
+	}
+	return bo.Hash, &bookEntry{order: o, bo: bo, expires: exp.Int64()}, nil
+}
+
+// remaining returns the taker token amount which can still be filled.
+func remaining(o *APIOrder) (*big.Int, error) {
+	r, ok := new(big.Int).SetString(o.TakerTokenAmount, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid takerTokenAmount %s", o.TakerTokenAmount)
+	}
+	for _, s := range []string{o.TakerTokenAmountFilled, o.TakerTokenAmountCancelled} {
+		if s == "" {
+			continue
+		}
+		i, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid taker token amount %s", s)
+		}
+		r.Sub(r, i)
+	}
+	return r, nil
+}
+
+// Reset replaces the content of the book with the orders of a snapshot.
+func (b *LocalBook) Reset(ob *Orderbook) error {
+	bids := map[string]*bookEntry{}
+	asks := map[string]*bookEntry{}
+	for _, o := range ob.Bids {
+		h, e, err := newBookEntry(o, "Bid", b.tokens)
+		if err != nil {
+			return err
+		}
+		bids[h] = e
+	}
+	for _, o := range ob.Asks {
+		h, e, err := newBookEntry(o, "Ask", b.tokens)
+		if err != nil {
+			return err
+		}
+		asks[h] = e
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.bids, b.asks = bids, asks
+	b.prune()
+	return nil
+}
+
+// Update inserts the order, or replaces the order with the same hash. The
+// order is removed instead if it's fully filled or cancelled, or expired.
+// It returns the book order and whether it was removed, or a nil order if
+// the order to remove wasn't in the book.
+func (b *LocalBook) Update(o APIOrder) (*BookOrder, bool, error) {
+	bidask := b.side(&o)
+	h, e, err := newBookEntry(o, bidask, b.tokens)
+	if err != nil {
+		return nil, false, err
+	}
+	r, err := remaining(&o)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	side := b.bids
+	if bidask == "Ask" {
+		side = b.asks
+	}
+	// look the order up before pruning, which might drop it silently
+	_, inBook := side[h]
+	b.prune()
+	if r.Sign() <= 0 || e.expires <= b.now().Unix() {
+		if !inBook {
+			return nil, false, nil
+		}
+		delete(side, h)
+		return e.bo, true, nil
+	}
+	side[h] = e
+	return e.bo, false, nil
+}
+
+// Remove deletes the order with the given hash from the book.
+func (b *LocalBook) Remove(hash string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, inBids := b.bids[hash]
+	_, inAsks := b.asks[hash]
+	delete(b.bids, hash)
+	delete(b.asks, hash)
+	return inBids || inAsks
+}
+
+// prune drops expired orders, b.mu must be held for writing.
+func (b *LocalBook) prune() {
+	now := b.now().Unix()
+	for _, side := range []map[string]*bookEntry{b.bids, b.asks} {
+		for h, e := range side {
+			if e.expires <= now {
+				delete(side, h)
+			}
+		}
+	}
+}
+
+// sorted returns the live orders of a side, best price first.
+func (b *LocalBook) sorted(side map[string]*bookEntry, desc bool) []BookOrder {
+	now := b.now().Unix()
+	bos := make([]BookOrder, 0, len(side))
+	for _, e := range side {
+		if e.expires > now {
+			bos = append(bos, *e.bo)
+		}
+	}
+	sort.Slice(bos, func(i, j int) bool {
+		if c := bos[i].Price.Cmp(bos[j].Price); c != 0 {
+			return (c > 0) == desc
+		}
+		return bos[i].Hash < bos[j].Hash
+	})
+	return bos
+}
+
+// BestBid returns the bid with the highest price.
+func (b *LocalBook) BestBid() (*BookOrder, bool) {
+	bids, _ := b.Depth(1)
+	if len(bids) == 0 {
+		return nil, false
+	}
+	return &bids[0], true
+}
+
+// BestAsk returns the ask with the lowest price.
+func (b *LocalBook) BestAsk() (*BookOrder, bool) {
+	_, asks := b.Depth(1)
+	if len(asks) == 0 {
+		return nil, false
+	}
+	return &asks[0], true
+}
+
+// Depth returns up to n best bids and asks, all of them if n <= 0.
+func (b *LocalBook) Depth(n int) (bids, asks []BookOrder) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	bids = b.sorted(b.bids, true)
+	asks = b.sorted(b.asks, false)
+	if n > 0 && len(bids) > n {
+		bids = bids[:n]
+	}
+	if n > 0 && len(asks) > n {
+		asks = asks[:n]
+	}
+	return bids, asks
+}
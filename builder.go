@@ -0,0 +1,241 @@
+package rrgo
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+const defaultOrderTTL = 24 * time.Hour
+
+var maxUint256 = new(big.Int).Lsh(big.NewInt(1), 256)
+
+// RandomSalt returns a cryptographically random 256-bit salt.
+func RandomSalt() (*big.Int, error) {
+	return rand.Int(rand.Reader, maxUint256)
+}
+
+// ParseAmount converts a decimal token amount like "1.5" to base units of
+// a token with the given number of decimals. Amounts with more fractional
+// digits than decimals are rejected rather than rounded.
+func ParseAmount(amount string, decimals int) (*big.Int, error) {
+	r, ok := new(big.Rat).SetString(amount)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a decimal number", amount)
+	}
+	if r.Sign() < 0 {
+		return nil, fmt.Errorf("amount %s is negative", amount)
+	}
+	r.Mul(r, new(big.Rat).SetInt(pow10(decimals)))
+	if !r.IsInt() {
+		return nil, fmt.Errorf("amount %s has more than %d decimals", amount, decimals)
+	}
+	return new(big.Int).Set(r.Num()), nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// FormatAmount formats an amount in base units of a token with the given
+// number of decimals as a decimal number, the inverse of ParseAmount.
+// Trailing zeros of the fraction are dropped.
+func FormatAmount(i *big.Int, decimals int) string {
+	if decimals <= 0 {
+		return i.String()
+	}
+	q, r := new(big.Int).QuoRem(new(big.Int).Abs(i), pow10(decimals), new(big.Int))
+	s := q.String()
+	if r.Sign() != 0 {
+		frac := fmt.Sprintf("%0*s", decimals, r.String())
+		s += "." + strings.TrimRight(frac, "0")
+	}
+	if i.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// OrderBuilder assembles an Order from typed values. Setters record the
+// first error, which is returned by Build.
+type OrderBuilder struct {
+	order      *Order
+	salt       *big.Int
+	ttl        time.Duration
+	expiration time.Time
+	now        func() time.Time
+	err        error
+}
+
+// NewOrderBuilder returns a builder for an order open to any taker,
+// without fees and expiring after a day.
+func NewOrderBuilder() *OrderBuilder {
+	o := &Order{}
+	o.Initialize()
+	return &OrderBuilder{
+		order: o,
+		ttl:   defaultOrderTTL,
+		now:   time.Now,
+	}
+}
+
+func (b *OrderBuilder) setErr(err error) *OrderBuilder {
+	if b.err == nil {
+		b.err = err
+	}
+	return b
+}
+
+func (b *OrderBuilder) setUint(dst *Uint256, name string, i *big.Int) *OrderBuilder {
+	if i == nil {
+		return b.setErr(fmt.Errorf("%s is nil", name))
+	}
+	u, err := NewUint256(i)
+	if err != nil {
+		return b.setErr(fmt.Errorf("%s: %s", name, err))
+	}
+	*dst = *u
+	return b
+}
+
+func (b *OrderBuilder) setDecimal(dst *Uint256, name, amount string, decimals int) *OrderBuilder {
+	i, err := ParseAmount(amount, decimals)
+	if err != nil {
+		return b.setErr(fmt.Errorf("%s: %s", name, err))
+	}
+	return b.setUint(dst, name, i)
+}
+
+func (b *OrderBuilder) Exchange(a Address) *OrderBuilder {
+	*b.order.ExchangeAddress = a
+	return b
+}
+
+func (b *OrderBuilder) Maker(a Address) *OrderBuilder {
+	*b.order.Maker = a
+	return b
+}
+
+func (b *OrderBuilder) Taker(a Address) *OrderBuilder {
+	*b.order.Taker = a
+	return b
+}
+
+func (b *OrderBuilder) MakerToken(a Address) *OrderBuilder {
+	*b.order.MakerToken = a
+	return b
+}
+
+func (b *OrderBuilder) TakerToken(a Address) *OrderBuilder {
+	*b.order.TakerToken = a
+	return b
+}
+
+func (b *OrderBuilder) FeeRecipient(a Address) *OrderBuilder {
+	*b.order.FeeRecipient = a
+	return b
+}
+
+// MakerTokenAmount sets the maker amount in token base units.
+func (b *OrderBuilder) MakerTokenAmount(i *big.Int) *OrderBuilder {
+	return b.setUint(b.order.MakerTokenAmount, "makerTokenAmount", i)
+}
+
+// TakerTokenAmount sets the taker amount in token base units.
+func (b *OrderBuilder) TakerTokenAmount(i *big.Int) *OrderBuilder {
+	return b.setUint(b.order.TakerTokenAmount, "takerTokenAmount", i)
+}
+
+// MakerTokenDecimal sets the maker amount as a decimal string scaled by
+// the maker token's decimals, see ParseAmount.
+func (b *OrderBuilder) MakerTokenDecimal(amount string, decimals int) *OrderBuilder {
+	return b.setDecimal(b.order.MakerTokenAmount, "makerTokenAmount", amount, decimals)
+}
+
+// TakerTokenDecimal sets the taker amount as a decimal string scaled by
+// the taker token's decimals.
+func (b *OrderBuilder) TakerTokenDecimal(amount string, decimals int) *OrderBuilder {
+	return b.setDecimal(b.order.TakerTokenAmount, "takerTokenAmount", amount, decimals)
+}
+
+func (b *OrderBuilder) MakerFee(i *big.Int) *OrderBuilder {
+	return b.setUint(b.order.MakerFee, "makerFee", i)
+}
+
+func (b *OrderBuilder) TakerFee(i *big.Int) *OrderBuilder {
+	return b.setUint(b.order.TakerFee, "takerFee", i)
+}
+
+// TTL sets the order to expire d after Build is called.
+func (b *OrderBuilder) TTL(d time.Duration) *OrderBuilder {
+	if d <= 0 {
+		return b.setErr(fmt.Errorf("TTL %s must be positive", d))
+	}
+	b.ttl = d
+	b.expiration = time.Time{}
+	return b
+}
+
+// Expiration sets an absolute expiration time, overriding the TTL.
+func (b *OrderBuilder) Expiration(t time.Time) *OrderBuilder {
+	b.expiration = t
+	return b
+}
+
+// Salt sets the salt instead of generating a random one.
+func (b *OrderBuilder) Salt(i *big.Int) *OrderBuilder {
+	b.salt = i
+	return b
+}
+
+// Build checks the required fields and returns a new unsigned order with
+// its hash computed.
+func (b *OrderBuilder) Build() (*Order, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	o := OrderFromBytes(b.order.Bytes())
+
+	zeroAddr := Address{}
+	switch {
+	case *o.ExchangeAddress == zeroAddr:
+		return nil, errors.New("missing exchange address")
+	case *o.Maker == zeroAddr:
+		return nil, errors.New("missing maker")
+	case *o.MakerToken == zeroAddr:
+		return nil, errors.New("missing maker token")
+	case *o.TakerToken == zeroAddr:
+		return nil, errors.New("missing taker token")
+	case o.MakerTokenAmount.Big().Sign() == 0:
+		return nil, errors.New("missing maker token amount")
+	case o.TakerTokenAmount.Big().Sign() == 0:
+		return nil, errors.New("missing taker token amount")
+	}
+
+	exp := b.expiration
+	if exp.IsZero() {
+		exp = b.now().Add(b.ttl)
+	}
+	u, err := NewUint256(big.NewInt(exp.Unix()))
+	if err != nil {
+		return nil, fmt.Errorf("expiration: %s", err)
+	}
+	*o.ExpirationTimestampInSec = *u
+
+	salt := b.salt
+	if salt == nil {
+		if salt, err = RandomSalt(); err != nil {
+			return nil, err
+		}
+	}
+	u, err = NewUint256(salt)
+	if err != nil {
+		return nil, fmt.Errorf("salt: %s", err)
+	}
+	*o.Salt = *u
+	copy(o.Signature.Hash[:], o.Hash())
+	return o, nil
+}
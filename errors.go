@@ -0,0 +1,119 @@
+package rrgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// General error codes of the 0x Standard Relayer API.
+const (
+	CodeValidationFailed        = 100
+	CodeMalformedJSON           = 101
+	CodeOrderSubmissionDisabled = 102
+	CodeThrottled               = 103
+)
+
+// Validation error codes of the 0x Standard Relayer API.
+const (
+	CodeRequiredField       = 1000
+	CodeIncorrectFormat     = 1001
+	CodeInvalidAddress      = 1002
+	CodeAddressNotSupported = 1003
+	CodeValueOutOfRange     = 1004
+	CodeInvalidSignature    = 1005
+	CodeUnsupportedOption   = 1006
+)
+
+// ErrOrderNotFound is returned by Client.Order when the relayer does not
+// know the requested order hash.
+var ErrOrderNotFound = errors.New("order not found")
+
+// ErrOrderNotSigned is returned by Client.SubmitOrder for orders without a
+// valid signature of their maker, like orders changed after signing.
+var ErrOrderNotSigned = errors.New("order is not signed")
+
+// ValidationError describes a single invalid field of a request.
+type ValidationError struct {
+	Field  string `json:"field"`
+	Code   int    `json:"code"`
+	Reason string `json:"reason"`
+}
+
+func (v ValidationError) String() string {
+	return fmt.Sprintf("%s: %s (%d)", v.Field, v.Reason, v.Code)
+}
+
+// ErrorResponse is returned by the Client when the relayer responds with
+// a non-2xx status. The body is decoded from the 0x error format.
+type ErrorResponse struct {
+	Response         *Response         `json:"-"`
+	Code             int               `json:"code"`
+	Reason           string            `json:"reason"`
+	ValidationErrors []ValidationError `json:"validationErrors"`
+}
+
+func (e *ErrorResponse) Error() string {
+	s := e.Reason
+	if e.Response != nil && e.Response.Response != nil {
+		r := e.Response.Response
+		s = fmt.Sprintf("%d %s", r.StatusCode, s)
+		if r.Request != nil {
+			s = fmt.Sprintf("%s %s: %s", r.Request.Method, r.Request.URL, s)
+		}
+	}
+	if e.Code != 0 {
+		s += fmt.Sprintf(" (%d)", e.Code)
+	}
+	if len(e.ValidationErrors) > 0 {
+		ves := make([]string, len(e.ValidationErrors))
+		for i, v := range e.ValidationErrors {
+			ves[i] = v.String()
+		}
+		s += " [" + strings.Join(ves, ", ") + "]"
+	}
+	return s
+}
+
+// IsValidation reports whether the request was rejected as invalid.
+func (e *ErrorResponse) IsValidation() bool {
+	return e.Code == CodeValidationFailed || e.Code == CodeMalformedJSON ||
+		len(e.ValidationErrors) > 0
+}
+
+// IsRateLimit reports whether the request was throttled by the relayer.
+func (e *ErrorResponse) IsRateLimit() bool {
+	return e.Code == CodeThrottled || e.statusCode() == http.StatusTooManyRequests
+}
+
+// IsServerError reports whether the relayer failed to handle the request.
+func (e *ErrorResponse) IsServerError() bool {
+	return e.statusCode() >= http.StatusInternalServerError
+}
+
+// statusCode returns the HTTP status of the response, 0 if there is none.
+func (e *ErrorResponse) statusCode() int {
+	if e.Response == nil || e.Response.Response == nil {
+		return 0
+	}
+	return e.Response.StatusCode
+}
+
+// checkResponse returns an *ErrorResponse if r has a non-2xx status.
+func checkResponse(r *Response) error {
+	if c := r.StatusCode; 200 <= c && c <= 299 {
+		return nil
+	}
+	errorResponse := &ErrorResponse{Response: r}
+	data, err := ioutil.ReadAll(r.Body)
+	if err == nil && len(data) > 0 {
+		json.Unmarshal(data, errorResponse)
+	}
+	if errorResponse.Reason == "" {
+		errorResponse.Reason = http.StatusText(r.StatusCode)
+	}
+	return errorResponse
+}
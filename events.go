@@ -0,0 +1,68 @@
+package rrgo
+
+import "time"
+
+// Event is delivered on WSClient.Events. It is one of the *Event types
+// below.
+type Event interface {
+	isEvent()
+}
+
+// SnapshotEvent carries the orderbook snapshot the local book was reset to.
+type SnapshotEvent struct {
+	Pair      string
+	Orderbook *Orderbook
+}
+
+// OrderAddedEvent is sent when an order is inserted into the local book or
+// replaces an order with the same hash.
+type OrderAddedEvent struct {
+	Pair      string
+	Order     APIOrder
+	BookOrder *BookOrder
+}
+
+// OrderRemovedEvent is sent when an update removes an order from the local
+// book because it was filled, cancelled or has expired.
+type OrderRemovedEvent struct {
+	Pair      string
+	Order     APIOrder
+	BookOrder *BookOrder
+}
+
+// MOTDEvent carries the relayer's message of the day.
+type MOTDEvent struct {
+	MOTD          string
+	Announcements []string
+}
+
+// ErrorEvent reports a problem with a received message or a failed
+// reconnection attempt. Pair is empty if the problem isn't specific to a
+// subscription. The feed keeps running.
+type ErrorEvent struct {
+	Pair string
+	Err  error
+}
+
+// ReconnectedEvent is sent after the websocket was re-opened and all pairs
+// subscribed again. SnapshotEvents follow. Cause is the error which broke
+// the connection, like a read timeout.
+type ReconnectedEvent struct {
+	Attempts int
+	Cause    error
+}
+
+// StaleEvent is sent when a subscription received no message for
+// Heartbeat.StaleAfter. A new snapshot is requested.
+type StaleEvent struct {
+	Pair string
+	Idle time.Duration
+}
+
+func (SnapshotEvent) isEvent()     {}
+func (OrderAddedEvent) isEvent()   {}
+func (OrderRemovedEvent) isEvent() {}
+func (MOTDEvent) isEvent()         {}
+func (ErrorEvent) isEvent()        {}
+func (ReconnectedEvent) isEvent()  {}
+func (StaleEvent) isEvent()        {}
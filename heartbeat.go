@@ -0,0 +1,148 @@
+package rrgo
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Heartbeat configures liveness checks of the websocket feed. Zero values
+// disable the respective check.
+type Heartbeat struct {
+	// PingInterval is how often a ping is sent to the server.
+	PingInterval time.Duration
+	// ReadTimeout breaks the connection if nothing, pongs included, is
+	// received for this long. Run then reconnects.
+	ReadTimeout time.Duration
+	// StaleAfter forces a new snapshot of a subscription which received
+	// no message for this long.
+	StaleAfter time.Duration
+}
+
+var DefaultHeartbeat = Heartbeat{
+	PingInterval: 20 * time.Second,
+	ReadTimeout:  time.Minute,
+}
+
+// WSStats are counters of a WSClient, for monitoring.
+type WSStats struct {
+	Messages          int64
+	PingsSent         int64
+	PongsReceived     int64
+	Reconnects        int64
+	StaleResnapshots  int64
+	LastMessage       time.Time
+	LastPong          time.Time
+	ConnectionStarted time.Time
+}
+
+type wsStats struct {
+	sync.Mutex
+	WSStats
+}
+
+// Stats returns a copy of the client's counters.
+func (c *WSClient) Stats() WSStats {
+	c.stats.Lock()
+	defer c.stats.Unlock()
+	return c.stats.WSStats
+}
+
+func (c *WSClient) count(f func(*WSStats)) {
+	c.stats.Lock()
+	defer c.stats.Unlock()
+	f(&c.stats.WSStats)
+}
+
+// extendDeadline pushes the read deadline of ws by Heartbeat.ReadTimeout.
+func (c *WSClient) extendDeadline(ws *websocket.Conn) {
+	if c.Heartbeat.ReadTimeout > 0 {
+		ws.SetReadDeadline(time.Now().Add(c.Heartbeat.ReadTimeout))
+	}
+}
+
+// initConn sets up the read deadline and pong handling of a new connection.
+func (c *WSClient) initConn(ws *websocket.Conn) {
+	c.extendDeadline(ws)
+	ws.SetPongHandler(func(string) error {
+		c.extendDeadline(ws)
+		c.count(func(s *WSStats) {
+			s.PongsReceived++
+			s.LastPong = time.Now()
+		})
+		return nil
+	})
+	c.count(func(s *WSStats) { s.ConnectionStarted = time.Now() })
+}
+
+// ping sends pings every Heartbeat.PingInterval until done is closed.
+func (c *WSClient) ping(done <-chan struct{}) {
+	if c.Heartbeat.PingInterval <= 0 {
+		return
+	}
+	t := time.NewTicker(c.Heartbeat.PingInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-t.C:
+			ws := c.ws()
+			if ws == nil {
+				continue
+			}
+			deadline := time.Now().Add(c.Heartbeat.PingInterval)
+			if err := ws.WriteControl(websocket.PingMessage, nil, deadline); err == nil {
+				c.count(func(s *WSStats) { s.PingsSent++ })
+			}
+		}
+	}
+}
+
+// watchStale resubscribes subscriptions which received nothing for
+// Heartbeat.StaleAfter, until done is closed.
+func (c *WSClient) watchStale(done <-chan struct{}) {
+	staleAfter := c.Heartbeat.StaleAfter
+	if staleAfter <= 0 {
+		return
+	}
+	t := time.NewTicker(staleAfter / 4)
+	defer t.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-t.C:
+		}
+		now := time.Now()
+		stale := []*WSOrderbook{}
+		idle := []time.Duration{}
+		c.mu.Lock()
+		for _, wso := range c.subs {
+			if d := now.Sub(wso.lastMessage); d > staleAfter {
+				// don't fire again before the new snapshot is due
+				wso.lastMessage = now
+				stale = append(stale, wso)
+				idle = append(idle, d)
+			}
+		}
+		ws := c.conn
+		c.mu.Unlock()
+		for i, wso := range stale {
+			c.count(func(s *WSStats) { s.StaleResnapshots++ })
+			if ws != nil {
+				// a failed write breaks the connection, which Run
+				// notices and reconnects
+				c.writeJSON(ws, wso.subscribeMessage())
+			}
+			// Run waits for this goroutine before closing the
+			// channel, so don't block past done
+			select {
+			case c.events <- StaleEvent{Pair: wso.Pair, Idle: idle[i]}:
+			case <-done:
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,268 @@
+// Command gentokens generates tokens.go, the token maps seeding
+// rrgo.DefaultTokens, from token lists in the etherwallet format (a JSON
+// array) or the tokenlists.org format (an object with a tokens array).
+//
+//	go run ./internal/gentokens [-o tokens.go] [-chain 1] source...
+//
+// Sources are files or http(s) URLs. A token listed again, in the same or
+// a later source, replaces the earlier entry. Entries with a malformed
+// address or a wrong EIP-55 checksum are skipped with a warning.
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const defaultDecimals = 18
+
+type token struct {
+	Address  string
+	Symbol   string
+	Name     string
+	Decimals int
+}
+
+// listEntry is a token of either list format, etherwallet has decimal
+// and no chain id.
+type listEntry struct {
+	Address  string `json:"address"`
+	Symbol   string `json:"symbol"`
+	Name     string `json:"name"`
+	Decimal  *int   `json:"decimal"`
+	Decimals *int   `json:"decimals"`
+	ChainID  int    `json:"chainId"`
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gentokens: ")
+	out := flag.String("o", "", "output `file`, stdout if empty")
+	pkg := flag.String("pkg", "rrgo", "package `name` of the output")
+	chain := flag.Int("chain", 1, "chain `id` of the tokens to take from tokenlists")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	all := []token{}
+	for _, src := range flag.Args() {
+		bs, err := fetch(src)
+		if err != nil {
+			log.Fatal(err)
+		}
+		ts, err := parse(bs, *chain)
+		if err != nil {
+			log.Fatalf("%s: %s", src, err)
+		}
+		all = append(all, ts...)
+	}
+	ts, warnings := clean(all)
+	for _, w := range warnings {
+		log.Println(w)
+	}
+	code, err := generate(*pkg, ts)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *out == "" {
+		os.Stdout.Write(code)
+		return
+	}
+	if err := ioutil.WriteFile(*out, code, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func fetch(src string) ([]byte, error) {
+	if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+		return ioutil.ReadFile(src)
+	}
+	c := http.Client{Timeout: time.Minute}
+	resp, err := c.Get(src)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", src, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// parse reads a token list of either format.
+func parse(bs []byte, chain int) ([]token, error) {
+	entries := []listEntry{}
+	bs = bytes.TrimSpace(bs)
+	if len(bs) > 0 && bs[0] == '{' {
+		list := struct {
+			Tokens []listEntry `json:"tokens"`
+		}{}
+		if err := json.Unmarshal(bs, &list); err != nil {
+			return nil, err
+		}
+		for _, e := range list.Tokens {
+			if e.ChainID == chain {
+				entries = append(entries, e)
+			}
+		}
+	} else if err := json.Unmarshal(bs, &entries); err != nil {
+		return nil, err
+	}
+
+	ts := make([]token, len(entries))
+	for i, e := range entries {
+		ts[i] = token{
+			Address:  e.Address,
+			Symbol:   strings.TrimSpace(e.Symbol),
+			Name:     strings.TrimSpace(e.Name),
+			Decimals: defaultDecimals,
+		}
+		if e.Decimals != nil {
+			ts[i].Decimals = *e.Decimals
+		} else if e.Decimal != nil {
+			ts[i].Decimals = *e.Decimal
+		}
+	}
+	return ts, nil
+}
+
+// checksum returns the EIP-55 mixed-case form of a lowercase hex address
+// without the 0x prefix.
+func checksum(lower string) string {
+	hash := hex.EncodeToString(crypto.Keccak256([]byte(lower)))
+	cs := []byte(lower)
+	for i, c := range cs {
+		if c >= 'a' && c <= 'f' && hash[i] >= '8' {
+			cs[i] = c - 'a' + 'A'
+		}
+	}
+	return string(cs)
+}
+
+// normalizeAddress validates a hex address and returns it in lowercase. A
+// mixed-case address has to carry a valid EIP-55 checksum.
+func normalizeAddress(a string) (string, error) {
+	if !strings.HasPrefix(a, "0x") && !strings.HasPrefix(a, "0X") {
+		return "", fmt.Errorf("address %s lacks the 0x prefix", a)
+	}
+	h := a[2:]
+	if len(h) != 40 {
+		return "", fmt.Errorf("address %s is not 20 bytes long", a)
+	}
+	if _, err := hex.DecodeString(h); err != nil {
+		return "", fmt.Errorf("address %s is not hex", a)
+	}
+	lower := strings.ToLower(h)
+	if h != lower && h != strings.ToUpper(h) && h != checksum(lower) {
+		return "", fmt.Errorf("address %s has a wrong checksum", a)
+	}
+	return "0x" + lower, nil
+}
+
+// clean validates the tokens, drops replaced entries and tells apart
+// tokens sharing a symbol. The result is ordered by address.
+func clean(ts []token) ([]token, []string) {
+	warnings := []string{}
+	byAddress := map[string]token{}
+	for _, t := range ts {
+		a, err := normalizeAddress(t.Address)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("skipping %s: %s", t.Symbol, err))
+			continue
+		}
+		if t.Symbol == "" {
+			warnings = append(warnings, fmt.Sprintf("skipping %s without symbol", a))
+			continue
+		}
+		if t.Decimals < 0 || t.Decimals > 77 {
+			warnings = append(warnings, fmt.Sprintf("skipping %s with %d decimals", a, t.Decimals))
+			continue
+		}
+		t.Address = a
+		byAddress[a] = t
+	}
+
+	bySymbol := map[string][]string{}
+	for a, t := range byAddress {
+		bySymbol[t.Symbol] = append(bySymbol[t.Symbol], a)
+	}
+	for sym, addrs := range bySymbol {
+		if len(addrs) == 1 {
+			continue
+		}
+		names := map[string]int{}
+		for _, a := range addrs {
+			names[byAddress[a].Name]++
+		}
+		for _, a := range addrs {
+			t := byAddress[a]
+			// the name if it tells the token apart, the address
+			// otherwise
+			if t.Name != "" && names[t.Name] == 1 {
+				t.Symbol = fmt.Sprintf("%s (%s)", sym, t.Name)
+			} else {
+				t.Symbol = fmt.Sprintf("%s (%s)", sym, a)
+			}
+			byAddress[a] = t
+		}
+		warnings = append(warnings, fmt.Sprintf("symbol %s is used by %d tokens", sym, len(addrs)))
+	}
+
+	out := make([]token, 0, len(byAddress))
+	for _, t := range byAddress {
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Address < out[j].Address })
+	sort.Strings(warnings)
+	return out, warnings
+}
+
+// generate returns the formatted Go source of the token maps.
+func generate(pkg string, ts []token) ([]byte, error) {
+	b := &bytes.Buffer{}
+	fmt.Fprintf(b, "// Code generated by gentokens; DO NOT EDIT.\n\npackage %s\n\n", pkg)
+
+	b.WriteString("// A2T maps token addresses to their symbols.\nvar A2T = map[string]string{\n")
+	for _, t := range ts {
+		fmt.Fprintf(b, "%q: %q,\n", t.Address, t.Symbol)
+	}
+	b.WriteString("}\n\n")
+
+	bySymbol := append([]token{}, ts...)
+	sort.Slice(bySymbol, func(i, j int) bool { return bySymbol[i].Symbol < bySymbol[j].Symbol })
+	b.WriteString("// T2A maps token symbols to their addresses.\nvar T2A = map[string]string{\n")
+	for i, t := range bySymbol {
+		if i > 0 && t.Symbol == bySymbol[i-1].Symbol {
+			return nil, fmt.Errorf("symbol %s is used twice after deduplication", t.Symbol)
+		}
+		fmt.Fprintf(b, "%q: %q,\n", t.Symbol, t.Address)
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString(`// A2D maps token addresses to their number of decimals, for the tokens
+// which don't have 18.
+var A2D = map[string]int{
+`)
+	for _, t := range ts {
+		if t.Decimals != defaultDecimals {
+			fmt.Fprintf(b, "%q: %d,\n", t.Address, t.Decimals)
+		}
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeAddress(t *testing.T) {
+	for _, c := range []struct {
+		in, want string
+	}{
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"},
+		{"0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359", "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359"},
+		{"0XFB6916095CA1DF60BB79CE92CE3EA74C37C5D359", "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359"},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", ""},
+		{"0x5aaeb6053f3e94c9b9a09f33669435e7ef1bea", ""},
+		{"5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", ""},
+		{"0xzaaeb6053f3e94c9b9a09f33669435e7ef1beaed", ""},
+	} {
+		a, err := normalizeAddress(c.in)
+		if c.want == "" {
+			if err == nil {
+				t.Fatalf("%s should be rejected", c.in)
+			}
+			continue
+		}
+		if err != nil || a != c.want {
+			t.Fatalf("%s: got %s, %v", c.in, a, err)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	etherwallet := `[
+		{"address": "0xE41d2489571d322189246DaFA5ebDe1F4699F498", "symbol": "ZRX", "decimal": 18},
+		{"address": "0xdac17f958d2ee523a2206206994597c13d831ec7", "symbol": "USDT", "decimal": 6},
+		{"address": "0x2accab9cb7a48c3e82286f0b2f8798d201f4ec3f", "symbol": "BTL", "name": "Battle"},
+		{"address": "0x92685e93956537c25bb75d5d47fca4266dd628b8", "symbol": "BTL", "name": "Bitlle"},
+		{"address": "0xE41d2489571d322189246DaFA5ebDe1F4699F49", "symbol": "BAD"}
+	]`
+	tokenlist := `{"name": "list", "tokens": [
+		{"chainId": 1, "address": "0xdAC17F958D2ee523a2206206994597C13D831ec7", "symbol": "USDT", "name": "Tether", "decimals": 6},
+		{"chainId": 42, "address": "0xd0a1e359811322d97991e03f863a0c30c2cf029c", "symbol": "WETH", "decimals": 18}
+	]}`
+	ts, err := parse([]byte(etherwallet), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	more, err := parse([]byte(tokenlist), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(more) != 1 {
+		t.Fatalf("took %d tokens of chain 1, want 1", len(more))
+	}
+	ts, warnings := clean(append(ts, more...))
+	if len(ts) != 4 || len(warnings) != 2 {
+		t.Fatalf("got %d tokens and warnings %v", len(ts), warnings)
+	}
+	code, err := generate("rrgo", ts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`"0xe41d2489571d322189246dafa5ebde1f4699f498": "ZRX",`,
+		`"BTL (Battle)": "0x2accab9cb7a48c3e82286f0b2f8798d201f4ec3f",`,
+		`"0xdac17f958d2ee523a2206206994597c13d831ec7": 6,`,
+	} {
+		if !strings.Contains(string(code), want) {
+			t.Fatalf("missing %s in\n%s", want, code)
+		}
+	}
+	if strings.Contains(string(code), `": 18,`) {
+		t.Fatalf("18 decimals listed in A2D\n%s", code)
+	}
+}
@@ -0,0 +1,162 @@
+package rrgo
+
+import "context"
+
+const defaultPerPage = 100
+
+// pager walks the pages of a list endpoint. fetch requests the page set in
+// lo and returns the number of items received and a key of the first one.
+//
+// The walk ends at an empty page rather than a short one, as the relayer
+// may return fewer items per page than asked for. It also ends at a page
+// repeating the previous one, in case the relayer ignores the page.
+type pager struct {
+	ctx   context.Context
+	c     *Client
+	lo    *ListOpts
+	fetch func() (int, string, *Response, error)
+	n, i  int
+	first string
+	last  bool
+	resp  *Response
+	err   error
+}
+
+func newPager(ctx context.Context, c *Client, lo *ListOpts) pager {
+	if lo.Page == 0 {
+		lo.Page = 1
+	}
+	if lo.PerPage == 0 {
+		lo.PerPage = defaultPerPage
+	}
+	return pager{ctx: ctx, c: c, lo: lo}
+}
+
+func (p *pager) next() bool {
+	if p.err != nil {
+		return false
+	}
+	if p.i+1 < p.n {
+		p.i++
+		return true
+	}
+	if p.last {
+		return false
+	}
+	// a throttled Client waits for the budget in DoContext already
+	if !p.c.rateLimit {
+		if p.err = p.c.limiter.acquire(p.ctx); p.err != nil {
+			return false
+		}
+	}
+	n, first, resp, err := p.fetch()
+	p.resp = resp
+	if err != nil {
+		p.err = err
+		return false
+	}
+	if n == 0 || (p.lo.Page > 1 && first == p.first) {
+		p.last, p.n = true, 0
+		return false
+	}
+	p.lo.Page++
+	p.n, p.i, p.first = n, 0, first
+	return true
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (p *pager) Err() error {
+	return p.err
+}
+
+// Response returns the response of the last fetched page.
+func (p *pager) Response() *Response {
+	return p.resp
+}
+
+// OrdersIterator iterates over orders across all result pages.
+type OrdersIterator struct {
+	pager
+	opts   OrdersOpts
+	orders []APIOrder
+}
+
+// AllOrders returns an iterator over all orders matching oo, starting at
+// oo.Page. Pages are fetched lazily, waiting for the rate limit to reset
+// when it is exhausted.
+func (c *Client) AllOrders(ctx context.Context, oo OrdersOpts) *OrdersIterator {
+	it := &OrdersIterator{opts: oo}
+	it.pager = newPager(ctx, c, &it.opts.ListOpts)
+	it.fetch = func() (int, string, *Response, error) {
+		orders, resp, err := c.OrdersContext(ctx, it.opts)
+		it.orders = orders
+		if len(orders) == 0 {
+			return 0, "", resp, err
+		}
+		return len(orders), orderKey(&orders[0]), resp, err
+	}
+	return it
+}
+
+// orderKey identifies o by its hash, or by its salt if it's malformed.
+func orderKey(o *APIOrder) string {
+	if order, err := o.ToOrder(); err == nil {
+		return order.HashHex()
+	}
+	return o.Salt
+}
+
+// Next advances to the next order. It returns false when there are no
+// more orders or an error occurred.
+func (it *OrdersIterator) Next() bool {
+	return it.next()
+}
+
+// Order returns the current order.
+func (it *OrdersIterator) Order() APIOrder {
+	return it.orders[it.i]
+}
+
+// PairsIterator iterates over token pairs across all result pages.
+type PairsIterator struct {
+	pager
+	opts  PairsOpts
+	pairs []Pair
+}
+
+// AllPairs returns an iterator over all token pairs matching po.
+func (c *Client) AllPairs(ctx context.Context, po PairsOpts) *PairsIterator {
+	it := &PairsIterator{opts: po}
+	it.pager = newPager(ctx, c, &it.opts.ListOpts)
+	it.fetch = func() (int, string, *Response, error) {
+		pairs, resp, err := c.PairsContext(ctx, it.opts)
+		it.pairs = pairs
+		if len(pairs) == 0 {
+			return 0, "", resp, err
+		}
+		return len(pairs), pairKey(&pairs[0]), resp, err
+	}
+	return it
+}
+
+// pairKey identifies p by the addresses of its tokens.
+func pairKey(p *Pair) string {
+	key := ""
+	for _, t := range []*Token{p.TokenA, p.TokenB} {
+		if t != nil {
+			key += t.Address
+		}
+		key += "/"
+	}
+	return key
+}
+
+// Next advances to the next pair.
+func (it *PairsIterator) Next() bool {
+	return it.next()
+}
+
+// Pair returns the current pair.
+func (it *PairsIterator) Pair() Pair {
+	return it.pairs[it.i]
+}
@@ -0,0 +1,395 @@
+package rrgo
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// mockRelayer is a local SRA v0 relayer serving the HTTP API and the
+// orderbook websocket channel from fixtures in testdata/relayer. Orders
+// submitted to it are added to its book and pushed to subscribers.
+type mockRelayer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	pairs   []jsonPair
+	fixture []APIOrder
+	orders  []APIOrder
+	fees    FeesResponse
+	// feesRequests are the valid requests posted to /fees
+	feesRequests []FeesRequest
+	subs         map[*mockWSConn]map[int]SubscribePayload
+	// maxPerPage caps per_page if > 0, and ignorePage serves the first
+	// page for any page, like some relayers do
+	maxPerPage int
+	ignorePage bool
+}
+
+// mockWSConn serializes the writes of the handler and of broadcasts.
+type mockWSConn struct {
+	mu   sync.Mutex
+	conn *websocket.Conn
+}
+
+func (c *mockWSConn) writeJSON(v interface{}) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn.WriteJSON(v)
+}
+
+func readFixture(dir, name string, v interface{}) error {
+	bs, err := ioutil.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bs, v)
+}
+
+// newMockRelayer starts a relayer seeded from token_pairs.json,
+// orders.json and fees.json in dir.
+func newMockRelayer(dir string) (*mockRelayer, error) {
+	mr := &mockRelayer{subs: map[*mockWSConn]map[int]SubscribePayload{}}
+	if err := readFixture(dir, "token_pairs.json", &mr.pairs); err != nil {
+		return nil, err
+	}
+	if err := readFixture(dir, "orders.json", &mr.fixture); err != nil {
+		return nil, err
+	}
+	if err := readFixture(dir, "fees.json", &mr.fees); err != nil {
+		return nil, err
+	}
+	mr.reset()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token_pairs", mr.handlePairs)
+	mux.HandleFunc("/orders", mr.handleOrders)
+	mux.HandleFunc("/order/", mr.handleOrder)
+	mux.HandleFunc("/order", mr.handleSubmit)
+	mux.HandleFunc("/orderbook", mr.handleOrderbook)
+	mux.HandleFunc("/fees", mr.handleFees)
+	mux.HandleFunc("/ws", mr.handleWS)
+	mr.Server = httptest.NewServer(mux)
+	return mr, nil
+}
+
+// reset drops the submitted orders and restores the paging.
+func (mr *mockRelayer) reset() {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	mr.orders = append([]APIOrder{}, mr.fixture...)
+	mr.feesRequests = nil
+	mr.maxPerPage, mr.ignorePage = 0, false
+}
+
+// WSURL is the websocket endpoint of the relayer.
+func (mr *mockRelayer) WSURL() string {
+	return "ws" + strings.TrimPrefix(mr.URL, "http") + "/ws"
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", mediaType)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status, code int, reason string, ves ...ValidationError) {
+	writeJSON(w, status, ErrorResponse{Code: code, Reason: reason, ValidationErrors: ves})
+}
+
+func allowMethod(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method != method {
+		writeError(w, http.StatusMethodNotAllowed, 0, "method not allowed")
+		return false
+	}
+	return true
+}
+
+// page cuts the page requested by the page and per_page parameters out of
+// n items, returning the bounds.
+func (mr *mockRelayer) page(r *http.Request, n int) (int, int) {
+	p, _ := strconv.Atoi(r.FormValue("page"))
+	pp, _ := strconv.Atoi(r.FormValue("per_page"))
+	mr.mu.Lock()
+	if p < 1 || mr.ignorePage {
+		p = 1
+	}
+	if pp < 1 {
+		pp = defaultPerPage
+	}
+	if mr.maxPerPage > 0 && pp > mr.maxPerPage {
+		pp = mr.maxPerPage
+	}
+	mr.mu.Unlock()
+	from, to := (p-1)*pp, p*pp
+	if from > n {
+		from = n
+	}
+	if to > n {
+		to = n
+	}
+	return from, to
+}
+
+func (mr *mockRelayer) handlePairs(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, "GET") {
+		return
+	}
+	a := strings.ToLower(r.FormValue("tokenA"))
+	b := strings.ToLower(r.FormValue("tokenB"))
+	mr.mu.Lock()
+	pairs := []jsonPair{}
+	for _, p := range mr.pairs {
+		ta, tb := p.TokenA.Address, p.TokenB.Address
+		if a != "" && a != ta && a != tb {
+			continue
+		}
+		if b != "" && b != ta && b != tb {
+			continue
+		}
+		pairs = append(pairs, p)
+	}
+	mr.mu.Unlock()
+	from, to := mr.page(r, len(pairs))
+	writeJSON(w, http.StatusOK, pairs[from:to])
+}
+
+// matchOrder reports whether o passes the filters of an /orders query.
+func matchOrder(r *http.Request, o *APIOrder) bool {
+	is := func(param string, values ...string) bool {
+		v := strings.ToLower(r.FormValue(param))
+		if v == "" {
+			return true
+		}
+		for _, value := range values {
+			if v == value {
+				return true
+			}
+		}
+		return false
+	}
+	return is("exchangeContractAddress", o.ExchangeAddress) &&
+		is("tokenAddress", o.MakerToken, o.TakerToken) &&
+		is("makerTokenAddress", o.MakerToken) &&
+		is("takerTokenAddress", o.TakerToken) &&
+		is("maker", o.Maker) &&
+		is("taker", o.Taker) &&
+		is("trader", o.Maker, o.Taker) &&
+		is("feeRecipient", o.FeeRecipient)
+}
+
+func (mr *mockRelayer) handleOrders(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, "GET") {
+		return
+	}
+	mr.mu.Lock()
+	orders := []APIOrder{}
+	for i := range mr.orders {
+		if matchOrder(r, &mr.orders[i]) {
+			orders = append(orders, mr.orders[i])
+		}
+	}
+	mr.mu.Unlock()
+	from, to := mr.page(r, len(orders))
+	writeJSON(w, http.StatusOK, orders[from:to])
+}
+
+func (mr *mockRelayer) handleOrder(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, "GET") {
+		return
+	}
+	hash := strings.ToLower(strings.TrimPrefix(r.URL.Path, "/order/"))
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	for _, o := range mr.orders {
+		order, err := o.ToOrder()
+		if err == nil && order.HashHex() == hash {
+			writeJSON(w, http.StatusOK, o)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, 0, "order not found")
+}
+
+func (mr *mockRelayer) handleSubmit(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, "POST") {
+		return
+	}
+	order := Order{}
+	if err := json.NewDecoder(r.Body).Decode(&order); err != nil {
+		writeError(w, http.StatusBadRequest, CodeMalformedJSON, err.Error())
+		return
+	}
+	if ves := order.Validate(ValidateOpts{NetworkID: NetworkMainnet}); len(ves) > 0 {
+		writeError(w, http.StatusBadRequest, CodeValidationFailed, "Validation failed", ves...)
+		return
+	}
+	bs, _ := order.MarshalJSON()
+	o := APIOrder{}
+	json.Unmarshal(bs, &o)
+
+	mr.mu.Lock()
+	mr.orders = append(mr.orders, o)
+	type push struct {
+		conn *mockWSConn
+		id   int
+	}
+	pushes := []push{}
+	for conn, subs := range mr.subs {
+		for id, sp := range subs {
+			if (sp.BaseTokenAddress == o.MakerToken && sp.QuoteTokenAddress == o.TakerToken) ||
+				(sp.BaseTokenAddress == o.TakerToken && sp.QuoteTokenAddress == o.MakerToken) {
+				pushes = append(pushes, push{conn, id})
+			}
+		}
+	}
+	mr.mu.Unlock()
+
+	for _, p := range pushes {
+		p.conn.writeJSON(UpdateMessage{
+			MessageFields: MessageFields{Type: "update", Channel: "orderbook", RequestID: p.id},
+			Payload:       &o,
+		})
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+// book returns the orderbook of a pair with up to limit orders per side,
+// all of them if limit <= 0. Like the live relayer, it lists the sides
+// worst price first, see Orderbook.Reverse.
+func (mr *mockRelayer) book(base, quote string, limit int) *Orderbook {
+	type priced struct {
+		o     APIOrder
+		price *big.Rat
+	}
+	asks, bids := []priced{}, []priced{}
+	mr.mu.Lock()
+	for _, o := range mr.orders {
+		switch {
+		case o.MakerToken == base && o.TakerToken == quote:
+			bo, _ := o.Process("Ask")
+			asks = append(asks, priced{o, bo.Price})
+		case o.MakerToken == quote && o.TakerToken == base:
+			bo, _ := o.Process("Bid")
+			bids = append(bids, priced{o, bo.Price})
+		}
+	}
+	mr.mu.Unlock()
+
+	side := func(ps []priced, bid bool) []APIOrder {
+		sort.SliceStable(ps, func(i, j int) bool {
+			// worst price first
+			c := ps[i].price.Cmp(ps[j].price)
+			return (c < 0) == bid && c != 0
+		})
+		if limit > 0 && len(ps) > limit {
+			ps = ps[len(ps)-limit:]
+		}
+		orders := make([]APIOrder, len(ps))
+		for i, p := range ps {
+			orders[i] = p.o
+		}
+		return orders
+	}
+	return &Orderbook{Asks: side(asks, false), Bids: side(bids, true)}
+}
+
+func (mr *mockRelayer) handleOrderbook(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, "GET") {
+		return
+	}
+	base := strings.ToLower(r.FormValue("baseTokenAddress"))
+	quote := strings.ToLower(r.FormValue("quoteTokenAddress"))
+	if base == "" || quote == "" {
+		writeError(w, http.StatusBadRequest, CodeValidationFailed, "Validation failed",
+			ValidationError{Field: "baseTokenAddress", Code: CodeRequiredField, Reason: "requires base and quote token"})
+		return
+	}
+	writeJSON(w, http.StatusOK, mr.book(base, quote, 0))
+}
+
+func (mr *mockRelayer) handleFees(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, "POST") {
+		return
+	}
+	fr := FeesRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&fr); err != nil {
+		writeError(w, http.StatusBadRequest, CodeMalformedJSON, err.Error())
+		return
+	}
+	ves := []ValidationError{}
+	if fr.ExchangeAddress != ExchangeAddresses[NetworkMainnet] {
+		ves = append(ves, ValidationError{Field: "exchangeContractAddress", Code: CodeAddressNotSupported, Reason: "unknown exchange"})
+	}
+	for field, a := range map[string]Address{"maker": fr.Maker, "makerTokenAddress": fr.MakerToken, "takerTokenAddress": fr.TakerToken} {
+		if a.IsZero() {
+			ves = append(ves, ValidationError{Field: field, Code: CodeRequiredField, Reason: "requires an address"})
+		}
+	}
+	for field, u := range map[string]Uint256{"makerTokenAmount": fr.MakerTokenAmount, "takerTokenAmount": fr.TakerTokenAmount} {
+		if u.Big().Sign() == 0 {
+			ves = append(ves, ValidationError{Field: field, Code: CodeValueOutOfRange, Reason: "must be greater than zero"})
+		}
+	}
+	if len(ves) > 0 {
+		sort.Slice(ves, func(i, j int) bool { return ves[i].Field < ves[j].Field })
+		writeError(w, http.StatusBadRequest, CodeValidationFailed, "Validation failed", ves...)
+		return
+	}
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+	mr.feesRequests = append(mr.feesRequests, fr)
+	writeJSON(w, http.StatusOK, mr.fees)
+}
+
+func (mr *mockRelayer) handleWS(w http.ResponseWriter, r *http.Request) {
+	upgrader := websocket.Upgrader{}
+	ws, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	conn := &mockWSConn{conn: ws}
+	mr.mu.Lock()
+	mr.subs[conn] = map[int]SubscribePayload{}
+	mr.mu.Unlock()
+	defer func() {
+		mr.mu.Lock()
+		delete(mr.subs, conn)
+		mr.mu.Unlock()
+		ws.Close()
+	}()
+
+	for {
+		sm := SubscribeMessage{}
+		if err := ws.ReadJSON(&sm); err != nil {
+			return
+		}
+		switch sm.Type {
+		case "subscribe":
+			sp := sm.Payload
+			sp.BaseTokenAddress = strings.ToLower(sp.BaseTokenAddress)
+			sp.QuoteTokenAddress = strings.ToLower(sp.QuoteTokenAddress)
+			mr.mu.Lock()
+			mr.subs[conn][sm.RequestID] = sp
+			mr.mu.Unlock()
+			if sp.Snapshot {
+				conn.writeJSON(SnapshotMessage{
+					MessageFields: MessageFields{Type: "snapshot", Channel: "orderbook", RequestID: sm.RequestID},
+					Payload:       mr.book(sp.BaseTokenAddress, sp.QuoteTokenAddress, sp.Limit),
+				})
+			}
+		case "unsubscribe":
+			mr.mu.Lock()
+			delete(mr.subs[conn], sm.RequestID)
+			mr.mu.Unlock()
+		}
+	}
+}
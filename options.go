@@ -0,0 +1,135 @@
+package rrgo
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	hchttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// Logger receives the Client's log output as messages with key-value
+// pairs. *slog.Logger satisfies it.
+type Logger interface {
+	Error(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Debug(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+}
+
+// retryLogger hands a Logger to retryablehttp. Since v0.6 it uses the
+// leveled methods, before that only Printf, whose messages are prefixed
+// with their level like "[ERR]".
+type retryLogger struct {
+	Logger
+}
+
+func (l retryLogger) Printf(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	switch {
+	case strings.HasPrefix(msg, "[ERR] "):
+		l.Error(strings.TrimPrefix(msg, "[ERR] "))
+	case strings.HasPrefix(msg, "[WARN] "):
+		l.Warn(strings.TrimPrefix(msg, "[WARN] "))
+	case strings.HasPrefix(msg, "[INFO] "):
+		l.Info(strings.TrimPrefix(msg, "[INFO] "))
+	default:
+		l.Debug(strings.TrimPrefix(msg, "[DEBUG] "))
+	}
+}
+
+// Option configures a Client created by NewClient.
+type Option func(*Client)
+
+// WithBaseURL sends the requests to the relayer API at u, overriding
+// RRGO_URL.
+func WithBaseURL(u string) Option {
+	return func(c *Client) {
+		c.baseUrl = u
+	}
+}
+
+// WithHTTPClient makes the requests with a copy of hc instead of a pooled
+// client with default settings. WithTransport and WithTimeout change the
+// copy, not hc, so they have to follow WithHTTPClient: the copy replaces
+// the settings of the options before it.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		cp := *hc
+		c.client.HTTPClient = &cp
+	}
+}
+
+// WithTransport sets the round tripper of the HTTP client.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) {
+		c.client.HTTPClient.Transport = rt
+	}
+}
+
+// WithTimeout limits the time of each attempt of a request. Use a context
+// to limit a request including its retries.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		c.client.HTTPClient.Timeout = d
+	}
+}
+
+// WithRetryMax sets how many times a failed request is retried, 5 by
+// default.
+func WithRetryMax(n int) Option {
+	return func(c *Client) {
+		c.client.RetryMax = n
+	}
+}
+
+// WithRetryWait bounds the wait between retries.
+func WithRetryWait(min, max time.Duration) Option {
+	return func(c *Client) {
+		c.client.RetryWaitMin = min
+		c.client.RetryWaitMax = max
+	}
+}
+
+// WithBackoff sets the policy computing the wait between retries, which
+// is exponential by default.
+func WithBackoff(b hchttp.Backoff) Option {
+	return func(c *Client) {
+		c.client.Backoff = b
+	}
+}
+
+// WithLogger logs the retries of requests, and the dumps of requests and
+// responses in debug mode, to l. By default retries aren't logged and the
+// dumps go to the standard logger.
+func WithLogger(l Logger) Option {
+	return func(c *Client) {
+		c.logger = l
+		c.client.Logger = retryLogger{l}
+	}
+}
+
+// WithUserAgent sets the User-Agent header of the requests.
+func WithUserAgent(ua string) Option {
+	return func(c *Client) {
+		c.userAgent = ua
+	}
+}
+
+// WithDebug switches dumping of requests and responses, overriding
+// RRGO_DEBUG.
+func WithDebug(debug bool) Option {
+	return func(c *Client) {
+		c.debug = debug
+	}
+}
+
+// WithRateLimit makes the Client throttle itself to the rate limit the
+// relayer announces. Requests wait while the budget is exhausted, and
+// throttled requests are retried after the Retry-After delay.
+func WithRateLimit(enable bool) Option {
+	return func(c *Client) {
+		c.rateLimit = enable
+	}
+}
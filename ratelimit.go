@@ -0,0 +1,141 @@
+package rrgo
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const headerRetryAfter = "Retry-After"
+
+// rateLimiter tracks the request budget announced by the relayer. When
+// the Client throttles itself, requests wait in acquire until the budget
+// allows them.
+type rateLimiter struct {
+	mu   sync.Mutex
+	rate Rate
+	// retryAt is when a 429 response allowed the next request
+	retryAt time.Time
+	now     func() time.Time
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{now: time.Now}
+}
+
+// reserve takes a request from the budget, or returns how long to wait
+// before trying again. l.mu must be held.
+func (l *rateLimiter) reserve() time.Duration {
+	now := l.now()
+	if now.Before(l.retryAt) {
+		return l.retryAt.Sub(now)
+	}
+	r := &l.rate
+	if r.RequestLimit == 0 {
+		return 0
+	}
+	if !r.Reset.IsZero() && !now.Before(r.Reset.Time) {
+		// a new window started, the next response tells its reset
+		r.RequestsRemaining = r.RequestLimit
+		r.Reset = Timestamp{}
+	}
+	if r.RequestsRemaining > 0 {
+		r.RequestsRemaining--
+		return 0
+	}
+	if r.Reset.IsZero() {
+		return 0
+	}
+	return r.Reset.Sub(now)
+}
+
+// acquire blocks until a request fits in the budget or ctx is done.
+func (l *rateLimiter) acquire(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		d := l.reserve()
+		l.mu.Unlock()
+		if d <= 0 {
+			return nil
+		}
+		select {
+		case <-time.After(d):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// update takes the budget from the rate limit headers of a response, and
+// the wait from Retry-After if it was throttled.
+func (l *rateLimiter) update(r *Response) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if r.Rate.RequestLimit > 0 {
+		l.rate = r.Rate
+	}
+	l.retryAfter(r.Response)
+}
+
+// retryAfter holds back requests as asked by a 429 response, returning
+// the wait. l.mu must be held.
+func (l *rateLimiter) retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	d, ok := parseRetryAfter(resp.Header.Get(headerRetryAfter), l.now())
+	if ok {
+		l.retryAt = l.now().Add(d)
+	}
+	return d, ok
+}
+
+// parseRetryAfter reads Retry-After as seconds or as an HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	if d := t.Sub(now); d > 0 {
+		return d, true
+	}
+	return 0, true
+}
+
+// Rate returns the request budget of the Client, as of the last response
+// and the requests sent since.
+func (c *Client) Rate() Rate {
+	c.limiter.mu.Lock()
+	defer c.limiter.mu.Unlock()
+	return c.limiter.rate
+}
+
+// throttle makes the retries of throttled requests wait as long as the
+// relayer asks, and holds back other requests meanwhile.
+func (c *Client) throttle() {
+	checkRetry := c.client.CheckRetry
+	c.client.CheckRetry = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		if err == nil && resp.StatusCode == http.StatusTooManyRequests && ctx.Err() == nil {
+			return true, nil
+		}
+		return checkRetry(ctx, resp, err)
+	}
+	backoff := c.client.Backoff
+	c.client.Backoff = func(min, max time.Duration, attempt int, resp *http.Response) time.Duration {
+		c.limiter.mu.Lock()
+		d, ok := c.limiter.retryAfter(resp)
+		c.limiter.mu.Unlock()
+		if ok {
+			return d
+		}
+		return backoff(min, max, attempt, resp)
+	}
+}
@@ -0,0 +1,46 @@
+package rrgo
+
+import (
+	"math/rand"
+	"time"
+)
+
+// ReconnectPolicy controls how a broken websocket is re-opened. The delay
+// before an attempt starts at InitialDelay and doubles up to MaxDelay.
+// Zero delays are taken from DefaultReconnectPolicy.
+type ReconnectPolicy struct {
+	InitialDelay time.Duration
+	MaxDelay     time.Duration
+	// Jitter randomizes each delay by up to this fraction of it, 0 to 1.
+	Jitter float64
+	// MaxAttempts limits the attempts per outage, 0 retries forever.
+	MaxAttempts int
+}
+
+var DefaultReconnectPolicy = ReconnectPolicy{
+	InitialDelay: 500 * time.Millisecond,
+	MaxDelay:     30 * time.Second,
+	Jitter:       0.2,
+	MaxAttempts:  0,
+}
+
+// delay returns how long to wait before the given attempt, counted from 1.
+func (p ReconnectPolicy) delay(attempt int) time.Duration {
+	if p.InitialDelay <= 0 {
+		p.InitialDelay = DefaultReconnectPolicy.InitialDelay
+	}
+	if p.MaxDelay <= 0 {
+		p.MaxDelay = DefaultReconnectPolicy.MaxDelay
+	}
+	d := p.InitialDelay
+	for i := 1; i < attempt && d < p.MaxDelay; i++ {
+		d *= 2
+	}
+	if d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if p.Jitter > 0 {
+		d = time.Duration(float64(d) * (1 + p.Jitter*(2*rand.Float64()-1)))
+	}
+	return d
+}
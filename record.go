@@ -0,0 +1,124 @@
+package rrgo
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// RecordedFrame is a line of a session recording.
+type RecordedFrame struct {
+	Time time.Time `json:"time"`
+	// Sent is set for frames sent by the client, like subscriptions.
+	Sent  bool   `json:"sent,omitempty"`
+	Frame string `json:"frame"`
+}
+
+// Recorder writes the websocket frames of a WSClient session as
+// newline-delimited JSON, one RecordedFrame per line. It is safe for
+// concurrent use.
+type Recorder struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+	err error
+	now func() time.Time
+}
+
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{enc: json.NewEncoder(w), now: time.Now}
+}
+
+// record appends a frame. A nil Recorder records nothing, and after a
+// failed write the Recorder stops, see Err.
+func (r *Recorder) record(sent bool, frame []byte) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return
+	}
+	r.err = r.enc.Encode(RecordedFrame{Time: r.now(), Sent: sent, Frame: string(frame)})
+}
+
+// Err returns the error which stopped the recording, if any.
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+// Replay feeds a session saved by a Recorder through the message handling
+// of c, in place of Run. No connection is opened, the subscriptions are
+// created from the recorded subscribe frames and can be listed with
+// Subscriptions. speed scales the recorded pace, 2 replays twice as fast
+// and 0 as fast as possible. Replay returns nil at the end of the
+// recording, and closes Events like Run.
+func (c *WSClient) Replay(ctx context.Context, r io.Reader, speed float64) error {
+	defer close(c.events)
+	dec := json.NewDecoder(r)
+	var last time.Time
+	for {
+		if c.isClosing() {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		rf := RecordedFrame{}
+		if err := dec.Decode(&rf); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if speed > 0 && !last.IsZero() {
+			select {
+			case <-time.After(time.Duration(float64(rf.Time.Sub(last)) / speed)):
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-c.closing:
+				return nil
+			}
+		}
+		last = rf.Time
+
+		if rf.Sent {
+			c.replaySent([]byte(rf.Frame))
+			continue
+		}
+		c.count(func(s *WSStats) {
+			s.Messages++
+			s.LastMessage = time.Now()
+		})
+		if err := c.handleMessage(ctx, []byte(rf.Frame)); err != nil {
+			c.emit(ctx, ErrorEvent{Err: err})
+		}
+	}
+}
+
+// replaySent applies a recorded subscribe or unsubscribe frame to the
+// subscriptions of c.
+func (c *WSClient) replaySent(frame []byte) {
+	sm := SubscribeMessage{}
+	if err := json.Unmarshal(frame, &sm); err != nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch sm.Type {
+	case "subscribe":
+		// resent subscriptions keep their book
+		if _, ok := c.subs[sm.RequestID]; !ok {
+			p := sm.Payload
+			c.addSub(sm.RequestID, p.BaseTokenAddress, p.QuoteTokenAddress, p.Limit)
+		}
+		if sm.RequestID > c.nextID {
+			c.nextID = sm.RequestID
+		}
+	case "unsubscribe":
+		delete(c.subs, sm.RequestID)
+	}
+}
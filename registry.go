@@ -0,0 +1,251 @@
+package rrgo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// defaultDecimals is the number of decimals of most ERC20 tokens, assumed
+// for tokens missing in A2D or in a TokenRegistry.
+const defaultDecimals = 18
+
+// ErrTokenNotFound is returned by TokenRegistry lookups of unknown tokens.
+var ErrTokenNotFound = errors.New("token not found")
+
+// AmbiguousSymbolError is returned by TokenRegistry.BySymbol when more
+// than one token uses the symbol.
+type AmbiguousSymbolError struct {
+	Symbol string
+	Tokens []TokenInfo
+}
+
+func (e *AmbiguousSymbolError) Error() string {
+	addrs := make([]string, len(e.Tokens))
+	for i, t := range e.Tokens {
+		addrs[i] = t.Address
+	}
+	return fmt.Sprintf("symbol %s is used by %d tokens: %s", e.Symbol, len(addrs), strings.Join(addrs, ", "))
+}
+
+// TokenInfo describes an ERC20 token.
+type TokenInfo struct {
+	Address string `json:"address"`
+	Symbol  string `json:"symbol"`
+	Name    string `json:"name,omitempty"`
+	// Decimals of the token amounts. Not to be confused with the
+	// Precision of /token_pairs, which is the precision of prices the
+	// relayer accepts.
+	Decimals  int `json:"decimals"`
+	NetworkID int `json:"networkId"`
+}
+
+// TokenRegistry holds the tokens of one network, looked up by address
+// or symbol. It is safe for concurrent use.
+type TokenRegistry struct {
+	NetworkID int
+
+	mu        sync.RWMutex
+	byAddress map[string]TokenInfo
+	// bySymbol lists the addresses using a symbol
+	bySymbol map[string][]string
+}
+
+func NewTokenRegistry(networkID int) *TokenRegistry {
+	return &TokenRegistry{
+		NetworkID: networkID,
+		byAddress: map[string]TokenInfo{},
+		bySymbol:  map[string][]string{},
+	}
+}
+
+//go:generate go run ./internal/gentokens -o tokens.go https://raw.githubusercontent.com/kvhnuke/etherwallet/mercury/app/scripts/tokens/ethTokens.json internal/gentokens/extra.json
+
+// DefaultTokens is the registry of mainnet tokens used to name the tokens
+// of orders and to scale their amounts, unless another one is passed to
+// ProcessWith, NewLocalBookWithTokens or WithWSTokens. It is seeded from
+// A2T and A2D.
+var DefaultTokens = newDefaultTokens()
+
+func newDefaultTokens() *TokenRegistry {
+	r := NewTokenRegistry(NetworkMainnet)
+	for addr, sym := range A2T {
+		t := TokenInfo{Address: addr, Symbol: sym, Decimals: defaultDecimals}
+		// the generated maps tell tokens sharing a symbol apart by
+		// the name, like "BTL (Battle)"
+		if i := strings.Index(sym, " ("); i > 0 && strings.HasSuffix(sym, ")") {
+			t.Symbol, t.Name = sym[:i], sym[i+2:len(sym)-1]
+		}
+		if d, ok := A2D[addr]; ok {
+			t.Decimals = d
+		}
+		if err := r.Add(t); err != nil {
+			panic(err)
+		}
+	}
+	return r
+}
+
+// Add inserts the token, or updates the token with the same address. The
+// NetworkID of the token defaults to the one of the registry.
+func (r *TokenRegistry) Add(t TokenInfo) error {
+	a, err := HexToAddress(t.Address)
+	if err != nil {
+		return err
+	}
+	t.Address = fmt.Sprintf("%#x", a[:])
+	if t.NetworkID == 0 {
+		t.NetworkID = r.NetworkID
+	}
+	if t.NetworkID != r.NetworkID {
+		return fmt.Errorf("token %s is on network %d, the registry on %d", t.Address, t.NetworkID, r.NetworkID)
+	}
+	if t.Decimals < 0 || t.Decimals > 77 {
+		return fmt.Errorf("token %s has invalid decimals %d", t.Address, t.Decimals)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if old, ok := r.byAddress[t.Address]; ok {
+		r.unindex(old)
+	}
+	r.byAddress[t.Address] = t
+	if t.Symbol != "" {
+		key := strings.ToUpper(t.Symbol)
+		r.bySymbol[key] = append(r.bySymbol[key], t.Address)
+	}
+	return nil
+}
+
+// unindex removes t from the symbol index, r.mu must be held.
+func (r *TokenRegistry) unindex(t TokenInfo) {
+	key := strings.ToUpper(t.Symbol)
+	addrs := r.bySymbol[key]
+	for i, a := range addrs {
+		if a == t.Address {
+			addrs = append(addrs[:i], addrs[i+1:]...)
+			break
+		}
+	}
+	if len(addrs) == 0 {
+		delete(r.bySymbol, key)
+	} else {
+		r.bySymbol[key] = addrs
+	}
+}
+
+// ByAddress returns the token at address.
+func (r *TokenRegistry) ByAddress(address string) (TokenInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	t, ok := r.byAddress[strings.ToLower(address)]
+	return t, ok
+}
+
+// BySymbol returns the token with the symbol, ignoring case. If several
+// tokens use it, it returns an *AmbiguousSymbolError listing them.
+func (r *TokenRegistry) BySymbol(symbol string) (TokenInfo, error) {
+	ts := r.AllBySymbol(symbol)
+	switch len(ts) {
+	case 0:
+		return TokenInfo{}, ErrTokenNotFound
+	case 1:
+		return ts[0], nil
+	}
+	return TokenInfo{}, &AmbiguousSymbolError{Symbol: symbol, Tokens: ts}
+}
+
+// AllBySymbol returns all tokens using the symbol, ordered by address.
+func (r *TokenRegistry) AllBySymbol(symbol string) []TokenInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ts := []TokenInfo{}
+	for _, a := range r.bySymbol[strings.ToUpper(symbol)] {
+		ts = append(ts, r.byAddress[a])
+	}
+	sort.Slice(ts, func(i, j int) bool { return ts[i].Address < ts[j].Address })
+	return ts
+}
+
+// Symbol returns the symbol of the token at address, or "" if unknown.
+func (r *TokenRegistry) Symbol(address string) string {
+	t, _ := r.ByAddress(address)
+	return t.Symbol
+}
+
+// Decimals returns the decimals of the token at address, 18 if unknown.
+func (r *TokenRegistry) Decimals(address string) int {
+	if t, ok := r.ByAddress(address); ok {
+		return t.Decimals
+	}
+	return defaultDecimals
+}
+
+// Tokens returns all tokens ordered by address.
+func (r *TokenRegistry) Tokens() []TokenInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ts := make([]TokenInfo, 0, len(r.byAddress))
+	for _, t := range r.byAddress {
+		ts = append(ts, t)
+	}
+	sort.Slice(ts, func(i, j int) bool { return ts[i].Address < ts[j].Address })
+	return ts
+}
+
+// LoadJSON adds the tokens of a JSON array of TokenInfo objects. Decimals
+// default to 18 if missing.
+func (r *TokenRegistry) LoadJSON(rd io.Reader) error {
+	raw := []json.RawMessage{}
+	if err := json.NewDecoder(rd).Decode(&raw); err != nil {
+		return err
+	}
+	for _, bs := range raw {
+		t := TokenInfo{Decimals: defaultDecimals}
+		if err := json.Unmarshal(bs, &t); err != nil {
+			return err
+		}
+		if err := r.Add(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LoadFile adds the tokens of a JSON file, see LoadJSON.
+func (r *TokenRegistry) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return r.LoadJSON(f)
+}
+
+// LoadPairs adds the tokens traded on the relayer which are missing from
+// the registry. The relayer only tells their addresses, so they have no
+// symbol and 18 decimals until updated.
+func (r *TokenRegistry) LoadPairs(ctx context.Context, c *Client) error {
+	it := c.AllPairs(ctx, PairsOpts{})
+	for it.Next() {
+		p := it.Pair()
+		for _, t := range []*Token{p.TokenA, p.TokenB} {
+			if t == nil {
+				continue
+			}
+			if _, ok := r.ByAddress(t.Address); ok {
+				continue
+			}
+			if err := r.Add(TokenInfo{Address: t.Address, Decimals: defaultDecimals}); err != nil {
+				return err
+			}
+		}
+	}
+	return it.Err()
+}
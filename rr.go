@@ -2,15 +2,19 @@ package rrgo
 
 import (
 	"bytes"
+	"context"
 
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"math/big"
+	"net/http"
 	"net/http/httputil"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -32,9 +36,14 @@ const (
 )
 
 type Client struct {
-	client  *hchttp.Client
-	debug   bool
-	baseUrl string
+	client    *hchttp.Client
+	debug     bool
+	baseUrl   string
+	userAgent string
+	logger    Logger
+	limiter   *rateLimiter
+	// rateLimit makes requests wait for the rate limit budget
+	rateLimit bool
 }
 
 func (r *Response) populateRate() {
@@ -51,7 +60,10 @@ func (r *Response) populateRate() {
 	}
 }
 
-func NewClient() *Client {
+// NewClient returns a client of the relayer API at RRGO_URL, or at the
+// RadarRelay endpoint if it's not set. RRGO_DEBUG enables dumping of
+// requests and responses. Options override these defaults.
+func NewClient(opts ...Option) *Client {
 	envD := os.Getenv(debugEnvVar)
 	envU := os.Getenv(endpointEnvVar)
 	if envU == "" {
@@ -59,16 +71,83 @@ func NewClient() *Client {
 	}
 
 	c := &Client{
-		client:  hchttp.NewClient(),
-		debug:   (envD != "") && (envD != "0"),
-		baseUrl: envU,
+		client:    hchttp.NewClient(),
+		debug:     (envD != "") && (envD != "0"),
+		baseUrl:   envU,
+		userAgent: userAgent,
+		limiter:   newRateLimiter(),
 	}
 	c.client.RetryMax = 5
 	c.client.Logger = log.New(ioutil.Discard, "", log.LstdFlags)
+	// Hand the last response to checkResponse once retries run out
+	c.client.ErrorHandler = hchttp.PassthroughErrorHandler
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.rateLimit {
+		c.throttle()
+	}
+	c.waitRetries()
 	return c
 }
 
+// retryWaitKey carries the *retryWait of a request in its context.
+type retryWaitKey struct{}
+
+// retryWait counts the attempts of a request.
+type retryWait struct {
+	attempt int
+}
+
+// waitRetries moves the wait between retries into CheckRetry, where the
+// context of the request can cut it short. retryablehttp before v0.6
+// sleeps regardless of the context.
+func (c *Client) waitRetries() {
+	checkRetry, backoff := c.client.CheckRetry, c.client.Backoff
+	c.client.CheckRetry = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		retry, checkErr := checkRetry(ctx, resp, err)
+		w, ok := ctx.Value(retryWaitKey{}).(*retryWait)
+		if !retry || !ok {
+			return retry, checkErr
+		}
+		attempt := w.attempt
+		w.attempt++
+		// the last attempt isn't retried
+		if attempt >= c.client.RetryMax {
+			return retry, checkErr
+		}
+		t := time.NewTimer(backoff(c.client.RetryWaitMin, c.client.RetryWaitMax, attempt, resp))
+		defer t.Stop()
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-t.C:
+		}
+		return retry, checkErr
+	}
+	c.client.Backoff = func(min, max time.Duration, attempt int, resp *http.Response) time.Duration {
+		return 0
+	}
+}
+
+// dump logs a request or response dump in debug mode.
+func (c *Client) dump(what string, bs []byte) {
+	if c.logger != nil {
+		c.logger.Debug(what, "dump", string(bs))
+		return
+	}
+	log.Printf("%s\n", string(bs))
+}
+
+// Do sends an API request and decodes the JSON response into out. It is
+// DoContext with context.Background().
 func (c *Client) Do(method string, path string, body, out interface{}) (*Response, error) {
+	return c.DoContext(context.Background(), method, path, body, out)
+}
+
+// DoContext sends an API request bound to ctx. Cancelling ctx aborts the
+// request in flight as well as any pending retry.
+func (c *Client) DoContext(ctx context.Context, method string, path string, body, out interface{}) (*Response, error) {
 
 	url := c.baseUrl + path
 
@@ -85,29 +164,43 @@ func (c *Client) Do(method string, path string, body, out interface{}) (*Respons
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(context.WithValue(ctx, retryWaitKey{}, &retryWait{}))
 
 	req.Close = true
 
 	req.Header.Add("Content-Type", mediaType)
 	req.Header.Add("Accept", mediaType)
-	req.Header.Add("User-Agent", userAgent)
+	req.Header.Add("User-Agent", c.userAgent)
 
 	if c.debug {
 		o, _ := httputil.DumpRequestOut(req.Request, true)
-		log.Printf("%s\n", string(o))
+		c.dump("request", o)
 	}
 
+	if c.rateLimit {
+		if err := c.limiter.acquire(ctx); err != nil {
+			return nil, err
+		}
+	}
 	resp, err := c.client.Do(req)
 	if err != nil {
+		if resp != nil {
+			resp.Body.Close()
+		}
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	response := Response{Response: resp}
 	response.populateRate()
+	c.limiter.update(&response)
 	if c.debug {
 		o, _ := httputil.DumpResponse(response.Response, true)
-		log.Printf("%s\n", string(o))
+		c.dump("response", o)
+	}
+
+	if err := checkResponse(&response); err != nil {
+		return &response, err
 	}
 
 	if out != nil {
@@ -125,6 +218,10 @@ func (c *Client) Do(method string, path string, body, out interface{}) (*Respons
 }
 
 func (c *Client) Pairs(po PairsOpts) ([]Pair, *Response, error) {
+	return c.PairsContext(context.Background(), po)
+}
+
+func (c *Client) PairsContext(ctx context.Context, po PairsOpts) ([]Pair, *Response, error) {
 
 	pairs := []Pair{}
 	v, err := query.Values(po)
@@ -136,7 +233,7 @@ func (c *Client) Pairs(po PairsOpts) ([]Pair, *Response, error) {
 		murl = murl + "?" + v.Encode()
 	}
 
-	resp, err := c.Do("GET", murl, nil, &pairs)
+	resp, err := c.DoContext(ctx, "GET", murl, nil, &pairs)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -154,6 +251,10 @@ func (t *Token) String() string {
 }
 
 func (c *Client) Orders(oo OrdersOpts) ([]APIOrder, *Response, error) {
+	return c.OrdersContext(context.Background(), oo)
+}
+
+func (c *Client) OrdersContext(ctx context.Context, oo OrdersOpts) ([]APIOrder, *Response, error) {
 	orders := []APIOrder{}
 	v, err := query.Values(oo)
 	if err != nil {
@@ -164,7 +265,7 @@ func (c *Client) Orders(oo OrdersOpts) ([]APIOrder, *Response, error) {
 		murl = murl + "?" + v.Encode()
 	}
 
-	resp, err := c.Do("GET", murl, nil, &orders)
+	resp, err := c.DoContext(ctx, "GET", murl, nil, &orders)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -183,6 +284,74 @@ func (c *Client) Orders(oo OrdersOpts) ([]APIOrder, *Response, error) {
 
 }
 
+// isHash reports whether s is a 0x-prefixed 32 byte hex hash.
+func isHash(s string) bool {
+	if len(s) != 66 || !strings.HasPrefix(strings.ToLower(s), "0x") {
+		return false
+	}
+	_, err := hex.DecodeString(s[2:])
+	return err == nil
+}
+
+// Order fetches a single order by its hash. It returns ErrOrderNotFound
+// if the relayer doesn't know the order.
+func (c *Client) Order(hash string) (*APIOrder, *Order, *Response, error) {
+	return c.OrderContext(context.Background(), hash)
+}
+
+func (c *Client) OrderContext(ctx context.Context, hash string) (*APIOrder, *Order, *Response, error) {
+	if !isHash(hash) {
+		return nil, nil, nil, fmt.Errorf("order hash %q must be 0x and 64 hex digits", hash)
+	}
+	ao := APIOrder{}
+	resp, err := c.DoContext(ctx, "GET", "/order/"+hash, nil, &ao)
+	if err != nil {
+		if er, ok := err.(*ErrorResponse); ok && er.statusCode() == http.StatusNotFound {
+			return nil, nil, resp, ErrOrderNotFound
+		}
+		return nil, nil, resp, err
+	}
+	o, err := ao.ToOrder()
+	if err != nil {
+		return nil, nil, resp, err
+	}
+	if strings.TrimPrefix(strings.ToLower(hash), "0x") != strings.TrimPrefix(o.HashHex(), "0x") {
+		return nil, nil, resp, fmt.Errorf("order hash mismatch, requested %s, got %s", hash, o.HashHex())
+	}
+	return &ao, o, resp, nil
+}
+
+// SubmitOrder posts a signed order to the relayer. If the relayer rejects
+// the order, the returned *ErrorResponse lists the offending fields.
+func (c *Client) SubmitOrder(order *Order) (*Response, error) {
+	return c.SubmitOrderContext(context.Background(), order)
+}
+
+func (c *Client) SubmitOrderContext(ctx context.Context, order *Order) (*Response, error) {
+	if order == nil || order.Signature == nil {
+		return nil, fmt.Errorf("order must be initialized and signed")
+	}
+	if !bytes.Equal(order.Signature.Hash[:], order.Hash()) || !order.Signature.Verify(order.Maker) {
+		return nil, ErrOrderNotSigned
+	}
+	return c.DoContext(ctx, "POST", "/order", order, nil)
+}
+
+// Fees asks the relayer which fee recipient and fees it requires for
+// an order.
+func (c *Client) Fees(fr *FeesRequest) (*FeesResponse, *Response, error) {
+	return c.FeesContext(context.Background(), fr)
+}
+
+func (c *Client) FeesContext(ctx context.Context, fr *FeesRequest) (*FeesResponse, *Response, error) {
+	fees := FeesResponse{}
+	resp, err := c.DoContext(ctx, "POST", "/fees", fr, &fees)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &fees, resp, nil
+}
+
 func (ob *Orderbook) String() string {
 	r := "\nAsks:\n"
 	for i := len(ob.Asks) - 1; i >= 0; i = i - 1 {
@@ -210,11 +379,15 @@ func (ob *Orderbook) Reverse() {
 }
 
 func (c *Client) Orderbook(oo OrderbookOpts) (*Orderbook, *Response, error) {
+	return c.OrderbookContext(context.Background(), oo)
+}
+
+func (c *Client) OrderbookContext(ctx context.Context, oo OrderbookOpts) (*Orderbook, *Response, error) {
 	ob := Orderbook{}
-	if oo.BaseTokenAddress == "" {
+	if oo.BaseTokenAddress.IsZero() {
 		return nil, nil, fmt.Errorf("missing baseTokenAddres in %s", oo)
 	}
-	if oo.QuoteTokenAddress == "" {
+	if oo.QuoteTokenAddress.IsZero() {
 		return nil, nil, fmt.Errorf("missing quoteTokenAddres in %s", oo)
 	}
 	v, err := query.Values(oo)
@@ -224,7 +397,7 @@ func (c *Client) Orderbook(oo OrderbookOpts) (*Orderbook, *Response, error) {
 	}
 	murl := "/orderbook?" + v.Encode()
 
-	resp, err := c.Do("GET", murl, nil, &ob)
+	resp, err := c.DoContext(ctx, "GET", murl, nil, &ob)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -1,15 +1,56 @@
 package rrgo
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/google/go-querystring/query"
 )
 
+// testRelayer serves the tests through RRGO_URL, unless it's set to run
+// them against a live relayer.
+var testRelayer *mockRelayer
+
+func TestMain(m *testing.M) {
+	if os.Getenv(endpointEnvVar) == "" {
+		mr, err := newMockRelayer(filepath.Join("testdata", "relayer"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		testRelayer = mr
+		os.Setenv(endpointEnvVar, mr.URL)
+	}
+	code := m.Run()
+	if testRelayer != nil {
+		testRelayer.Close()
+	}
+	os.Exit(code)
+}
+
+// needRelayer skips tests which depend on the content of the mock relayer.
+func needRelayer(t *testing.T) {
+	if testRelayer == nil {
+		t.Skipf("%s is set, not using the mock relayer", endpointEnvVar)
+	}
+}
+
 func TestTokenPairs(t *testing.T) {
 	c := NewClient()
-	pr := PairsOpts{TokenA: "WETH"}
+	pr := PairsOpts{TokenA: MustHexToAddress(T2A["WETH"])}
 	pairs, _, err := c.Pairs(pr)
 	if err != nil {
 		t.Fatal(err)
@@ -26,6 +67,15 @@ func TestTokenPairs(t *testing.T) {
 			A2T[p.TokenA.Address],
 			A2T[p.TokenB.Address])
 	}
+
+	needRelayer(t)
+	pairs, _, err = c.Pairs(PairsOpts{TokenA: MustHexToAddress(T2A["DGD"])})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pairs) != 1 || pairs[0].TokenA.Address != T2A["DGD"] || pairs[0].TokenA.Precision != 5 {
+		t.Fatalf("unexpected DGD pairs %v", pairs)
+	}
 }
 
 func TestOrders(t *testing.T) {
@@ -40,6 +90,25 @@ func TestOrders(t *testing.T) {
 		log.Println(o)
 	}
 
+	needRelayer(t)
+	orders, _, err = c.Orders(OrdersOpts{MakerToken: MustHexToAddress(T2A["MKR"])})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 1 {
+		t.Fatalf("got %d MKR orders, want 1", len(orders))
+	}
+	o, err := orders[0].ToOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ao, _, _, err := c.Order(o.HashHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ao.MakerToken != T2A["MKR"] {
+		t.Fatalf("fetched the wrong order %v", ao)
+	}
 }
 
 func TestAddressImport(t *testing.T) {
@@ -58,8 +127,8 @@ func TestOrderbook(t *testing.T) {
 	qt := "WETH"
 	lim := 10
 	ob, _, err := c.Orderbook(OrderbookOpts{
-		BaseTokenAddress:  T2A[bt],
-		QuoteTokenAddress: T2A[qt],
+		BaseTokenAddress:  MustHexToAddress(T2A[bt]),
+		QuoteTokenAddress: MustHexToAddress(T2A[qt]),
 	},
 	)
 
@@ -97,13 +166,24 @@ func TestOrderbook(t *testing.T) {
 		}
 		log.Println(bo)
 	}
+
+	needRelayer(t)
+	if len(ob.Asks) != 3 || len(ob.Bids) != 3 {
+		t.Fatalf("got %d asks and %d bids, want 3 each", len(ob.Asks), len(ob.Bids))
+	}
+	ask, _ := ob.Asks[0].Process("Ask")
+	bid, _ := ob.Bids[0].Process("Bid")
+	if ask.PriceFloat() != 0.0012 || bid.PriceFloat() != 0.0011 {
+		t.Fatalf("best ask %f and bid %f, want 0.0012 and 0.0011", ask.PriceFloat(), bid.PriceFloat())
+	}
 }
 
 func TestWSOrderbook(t *testing.T) {
 	testPairEnvvar := "RRGO_TEST_PAIR"
 	p := os.Getenv(testPairEnvvar)
 	if len(p) == 0 {
-		t.Fatalf("set %s", testPairEnvvar)
+		testWSMockRelayer(t)
+		return
 	}
 	ts := strings.Split(p, "/")
 	if len(ts) != 2 {
@@ -116,5 +196,977 @@ func TestWSOrderbook(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	wso.Run()
+	go func() {
+		for ev := range wso.Events() {
+			log.Printf("%#v\n", ev)
+		}
+	}()
+	wso.Run(context.Background())
+}
+
+// testWSMockRelayer follows ZRX/WETH on the mock relayer and submits an
+// order, which has to show up in the local book. It subscribes with
+// checksummed addresses, while the relayer sends lowercase ones.
+func testWSMockRelayer(t *testing.T) {
+	needRelayer(t)
+	zrxHex, wethHex := MustHexToAddress(T2A["ZRX"]).Hex(), MustHexToAddress(T2A["WETH"]).Hex()
+	wso, err := newWSClient(testRelayer.WSURL()).Subscribe(zrxHex, wethHex, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wso.BaseTokenAddress != T2A["ZRX"] || wso.Pair != "ZRX/WETH" {
+		t.Fatalf("subscription not normalized: %s %s", wso.BaseTokenAddress, wso.Pair)
+	}
+	go wso.Run(context.Background())
+	defer wso.Close()
+	defer testRelayer.reset()
+
+	ev := <-wso.Events()
+	if sev, ok := ev.(SnapshotEvent); !ok || len(sev.Orderbook.Bids) != 2 {
+		t.Fatalf("unexpected event %#v", ev)
+	}
+	if ask, _ := wso.BestAsk(); ask.PriceFloat() != 0.0012 {
+		t.Fatalf("wrong best ask %v", ask)
+	}
+
+	signer, err := NewKeySignerFromHex("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exchange := ExchangeAddresses[NetworkMainnet]
+	zrx, _ := HexToAddress(T2A["ZRX"])
+	weth, _ := HexToAddress(T2A["WETH"])
+	o, err := NewOrderBuilder().Exchange(exchange).Maker(signer.Address()).
+		MakerToken(weth).TakerToken(zrx).
+		MakerTokenDecimal("0.115", 18).TakerTokenDecimal("100", 18).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewClient().SubmitOrder(o); err != ErrOrderNotSigned {
+		t.Fatalf("submitted an unsigned order: %v", err)
+	}
+	if err := o.Sign(signer); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewClient().SubmitOrder(o); err != nil {
+		t.Fatal(err)
+	}
+
+	ev = <-wso.Events()
+	if aev, ok := ev.(OrderAddedEvent); !ok || aev.BookOrder.Hash != o.HashHex() {
+		t.Fatalf("unexpected event %#v", ev)
+	}
+	if bid, _ := wso.BestBid(); bid.Hash != o.HashHex() {
+		t.Fatalf("submitted order isn't the best bid %v", bid)
+	}
+}
+
+func TestContextCancel(t *testing.T) {
+	release := make(chan struct{})
+	hang := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer hang.Close()
+	defer close(release)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+
+	for _, url := range []string{hang.URL, failing.URL} {
+		// the retries wait longer than the deadline
+		c := NewClient(WithBaseURL(url), WithRetryWait(5*time.Second, 5*time.Second))
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		start := time.Now()
+		_, _, err := c.PairsContext(ctx, PairsOpts{})
+		cancel()
+		if err == nil {
+			t.Fatalf("%s: expected error from cancelled context", url)
+		}
+		if d := time.Since(start); d > time.Second {
+			t.Fatalf("%s: cancellation took %s", url, d)
+		}
+	}
+}
+
+func TestErrorResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code": 100, "reason": "Validation failed",
+			"validationErrors": [{"field": "tokenA", "code": 1002, "reason": "Invalid address"}]}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient()
+	c.baseUrl = srv.URL
+	_, resp, err := c.Pairs(PairsOpts{})
+	er, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("expected *ErrorResponse, got %v", err)
+	}
+	if resp == nil || er.Response != resp {
+		t.Fatal("ErrorResponse should carry the *Response")
+	}
+	if !er.IsValidation() || er.IsRateLimit() || er.IsServerError() {
+		t.Fatalf("wrong classification of %s", er)
+	}
+	if len(er.ValidationErrors) != 1 || er.ValidationErrors[0].Code != CodeInvalidAddress {
+		t.Fatalf("wrong validation errors %v", er.ValidationErrors)
+	}
+	log.Println(er)
+
+	// errors built without a response, like the mock relayer's
+	er = &ErrorResponse{Code: CodeThrottled, Reason: "Throttled"}
+	if er.Error() != "Throttled (103)" || !er.IsRateLimit() || er.IsServerError() {
+		t.Fatalf("wrong error without response %s", er)
+	}
+}
+
+// testLogger collects the messages logged by a Client.
+type testLogger struct {
+	mu   sync.Mutex
+	msgs []string
+}
+
+func (l *testLogger) log(msg string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.msgs = append(l.msgs, msg)
+}
+
+func (l *testLogger) Error(msg string, kvs ...interface{}) { l.log(msg) }
+func (l *testLogger) Info(msg string, kvs ...interface{})  { l.log(msg) }
+func (l *testLogger) Debug(msg string, kvs ...interface{}) { l.log(msg) }
+func (l *testLogger) Warn(msg string, kvs ...interface{})  { l.log(msg) }
+
+func TestClientOptions(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ua := r.Header.Get("User-Agent"); ua != "rrgo-test" {
+			t.Errorf("unexpected user agent %s", ua)
+		}
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, "[]")
+	}))
+	defer srv.Close()
+
+	l := &testLogger{}
+	c := NewClient(
+		WithBaseURL(srv.URL),
+		WithUserAgent("rrgo-test"),
+		WithRetryMax(2),
+		WithRetryWait(time.Millisecond, time.Millisecond),
+		WithLogger(l),
+		WithDebug(true),
+	)
+	if _, _, err := c.Pairs(PairsOpts{}); err != nil {
+		t.Fatal(err)
+	}
+	if calls := atomic.LoadInt32(&calls); calls != 3 {
+		t.Fatalf("made %d calls, want 3", calls)
+	}
+	dumps := 0
+	for _, msg := range l.msgs {
+		if msg == "request" || msg == "response" {
+			dumps++
+		}
+	}
+	if dumps != 2 {
+		t.Fatalf("logged %d dumps, want 2: %v", dumps, l.msgs)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	c = NewClient(WithBaseURL(srv.URL), WithUserAgent("rrgo-test"), WithRetryMax(0))
+	_, _, err := c.Pairs(PairsOpts{})
+	if er, ok := err.(*ErrorResponse); !ok || er.Response.StatusCode != http.StatusBadGateway {
+		t.Fatalf("expected the 502 without retries, got %v", err)
+	}
+
+	hang := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer hang.Close()
+	c = NewClient(WithBaseURL(hang.URL), WithRetryMax(0), WithTimeout(50*time.Millisecond))
+	if _, _, err := c.Pairs(PairsOpts{}); err == nil {
+		t.Fatal("request should time out")
+	}
+
+	// the client passed in is copied, not changed
+	c = NewClient(WithHTTPClient(http.DefaultClient), WithTimeout(time.Minute), WithTransport(&http.Transport{}))
+	if http.DefaultClient.Timeout != 0 || http.DefaultClient.Transport != nil {
+		t.Fatal("options changed http.DefaultClient")
+	}
+	if c.client.HTTPClient == http.DefaultClient || c.client.HTTPClient.Timeout != time.Minute {
+		t.Fatal("options not applied to the copy of the client")
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	l := newRateLimiter()
+	l.now = func() time.Time { return now }
+	l.update(&Response{
+		Response: &http.Response{StatusCode: http.StatusOK},
+		Rate:     Rate{RequestLimit: 2, RequestsRemaining: 1, Reset: Timestamp{now.Add(10 * time.Second)}},
+	})
+	if d := l.reserve(); d != 0 {
+		t.Fatalf("request within the budget waits %s", d)
+	}
+	if d := l.reserve(); d != 10*time.Second {
+		t.Fatalf("request over the budget waits %s, want 10s", d)
+	}
+	now = now.Add(10 * time.Second)
+	if d := l.reserve(); d != 0 || l.rate.RequestsRemaining != 1 {
+		t.Fatalf("budget not renewed after the reset, wait %s, rate %v", d, l.rate)
+	}
+
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "59")
+		w.Header().Set(headerRateReset, strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10))
+		fmt.Fprint(w, "[]")
+	}))
+	defer srv.Close()
+
+	c := NewClient(WithBaseURL(srv.URL), WithRetryMax(1), WithRateLimit(true))
+	start := time.Now()
+	if _, _, err := c.Pairs(PairsOpts{}); err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d < time.Second {
+		t.Fatalf("retried after %s, before Retry-After", d)
+	}
+	if r := c.Rate(); r.RequestLimit != 60 || r.RequestsRemaining != 59 {
+		t.Fatalf("unexpected budget %v", r)
+	}
+}
+
+func testOrder(t *testing.T) *Order {
+	o, err := NewOrder(
+		"0x9e56625509c2f60af937f23b7b532600390e8c8b",
+		"0x0000000000000000000000000000000000000000",
+		"0xe41d2489571d322189246dafa5ebde1f4699f498",
+		"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2",
+		"0xa258b39954cef5cb142fd567a46cddb31a670124",
+		"0x12459c951127e0c374ff9105dda097662a027093",
+		"10000000000000000000",
+		"20000000000000000",
+		"0",
+		"0",
+		"1893456000",
+		"72815605309767148542369036452358447015239768425124187520917133212271542312961",
+		"27",
+		"0x61a3ed31b43c8780e905a260a35faefcc527be7516aa11c0256729b5b351bc33",
+		"0x40349190569279751135161d22529dc25add4f6069af05be04cacbda2ace2254",
+		"0",
+		"0",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return o
+}
+
+func TestOrder(t *testing.T) {
+	o := testOrder(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/order/"+o.HashHex() {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		bs, _ := o.MarshalJSON()
+		w.Write(bs)
+	}))
+	defer srv.Close()
+
+	c := NewClient()
+	c.baseUrl = srv.URL
+	ao, order, _, err := c.Order(o.HashHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ao.Maker != "0x9e56625509c2f60af937f23b7b532600390e8c8b" {
+		t.Fatalf("wrong maker %s", ao.Maker)
+	}
+	if order.Signature.Hash != o.Signature.Hash {
+		t.Fatal("signature hash not filled")
+	}
+
+	_, _, _, err = c.Order(fmt.Sprintf("0x%064x", 1))
+	if err != ErrOrderNotFound {
+		t.Fatalf("expected ErrOrderNotFound, got %v", err)
+	}
+
+	// malformed hashes aren't sent
+	c.baseUrl = "http://127.0.0.1:1"
+	for _, hash := range []string{"", "0x1234", "../orders?x", o.HashHex()[2:], "0x" + strings.Repeat("g", 64), o.HashHex() + "/.."} {
+		if _, _, _, err := c.Order(hash); err == nil || err == ErrOrderNotFound || strings.Contains(err.Error(), "127.0.0.1") {
+			t.Fatalf("%q: expected a malformed hash error, got %v", hash, err)
+		}
+	}
+}
+
+func TestSubmitOrder(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/order" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		o := Order{}
+		if err := json.NewDecoder(r.Body).Decode(&o); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code": 101, "reason": "Malformed JSON"}`))
+			return
+		}
+		if new(big.Int).SetBytes(o.MakerFee[:]).Sign() == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code": 100, "reason": "Validation failed",
+				"validationErrors": [{"field": "makerFee", "code": 1004, "reason": "Value out of range"}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	c := NewClient()
+	c.baseUrl = srv.URL
+	signer, err := NewKeySignerFromHex("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := testOrder(t)
+	if _, err := c.SubmitOrder(o); err != ErrOrderNotSigned {
+		t.Fatalf("submitted an order not signed by its maker: %v", err)
+	}
+	*o.Maker = signer.Address()
+	if err := o.Sign(signer); err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.SubmitOrder(o)
+	er, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("expected *ErrorResponse, got %v", err)
+	}
+	if len(er.ValidationErrors) != 1 || er.ValidationErrors[0].Field != "makerFee" {
+		t.Fatalf("wrong validation errors %v", er.ValidationErrors)
+	}
+
+	o.MakerFee[31] = 1
+	if _, err := c.SubmitOrder(o); err != ErrOrderNotSigned {
+		t.Fatalf("submitted an order signed before it was changed: %v", err)
+	}
+	// a stale signature claiming the new hash
+	copy(o.Signature.Hash[:], o.Hash())
+	if _, err := c.SubmitOrder(o); err != ErrOrderNotSigned {
+		t.Fatalf("submitted an order with a stale signature: %v", err)
+	}
+	if err := o.Sign(signer); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := c.SubmitOrder(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("unexpected status %d", resp.StatusCode)
+	}
+}
+
+func TestFees(t *testing.T) {
+	needRelayer(t)
+	defer testRelayer.reset()
+	c := NewClient()
+	o := testOrder(t)
+	fees, _, err := c.Fees(o.FeesRequest())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fees.FeeRecipient != MustHexToAddress("0xa258b39954cef5cb142fd567a46cddb31a670124") ||
+		fees.MakerFee.Big().String() != "100000000000000" || fees.TakerFee.Big().Sign() != 0 {
+		t.Fatalf("wrong fees %+v", fees)
+	}
+	testRelayer.mu.Lock()
+	frs := testRelayer.feesRequests
+	testRelayer.mu.Unlock()
+	if len(frs) != 1 || frs[0] != *o.FeesRequest() {
+		t.Fatalf("relayer got fees requests %+v, want %+v", frs, *o.FeesRequest())
+	}
+
+	_, _, err = c.Fees(&FeesRequest{ExchangeAddress: ExchangeAddresses[NetworkMainnet]})
+	er, ok := err.(*ErrorResponse)
+	if !ok || !er.IsValidation() || len(er.ValidationErrors) != 5 || er.ValidationErrors[0].Field != "maker" {
+		t.Fatalf("expected validation errors of the empty request, got %v", err)
+	}
+
+	signer, err := NewKeySignerFromHex("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	if err != nil {
+		t.Fatal(err)
+	}
+	*o.Maker = signer.Address()
+	if err := o.Sign(signer); err != nil || !o.Signature.Verify(o.Maker) {
+		t.Fatalf("signing failed: %v", err)
+	}
+	o.ApplyFees(fees)
+	if *o.FeeRecipient != fees.FeeRecipient {
+		t.Fatal("fee recipient not applied")
+	}
+	if o.Signature.Verify(o.Maker) {
+		t.Fatal("signature of the order without fees kept")
+	}
+	if _, err := c.SubmitOrder(o); err != ErrOrderNotSigned {
+		t.Fatalf("submitted an order not signed after applying fees: %v", err)
+	}
+}
+
+func TestAllPairs(t *testing.T) {
+	total := 250
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		pairs := []string{}
+		for i := (page - 1) * perPage; i < page*perPage && i < total; i++ {
+			pairs = append(pairs, fmt.Sprintf(
+				`{"tokenA": {"address": "0x%040x", "minAmount": "0", "maxAmount": "1", "precision": 5},
+				"tokenB": {"address": "0x%040x", "minAmount": "0", "maxAmount": "1", "precision": 5}}`, i, i+1))
+		}
+		// the 3 pages and the empty 4th use up the budget
+		w.Header().Set(headerRateLimit, "4")
+		w.Header().Set(headerRateRemaining, strconv.Itoa(4-page))
+		w.Header().Set(headerRateReset, strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10))
+		w.Write([]byte("[" + strings.Join(pairs, ",") + "]"))
+	}))
+	defer srv.Close()
+
+	// a throttled Client must not take each page from the budget twice
+	for _, c := range []*Client{NewClient(WithBaseURL(srv.URL)), NewClient(WithBaseURL(srv.URL), WithRateLimit(true))} {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		it := c.AllPairs(ctx, PairsOpts{})
+		n := 0
+		for it.Next() {
+			if it.Pair().TokenA.Address != fmt.Sprintf("0x%040x", n) {
+				t.Fatalf("unexpected pair %d: %s", n, it.Pair().TokenA)
+			}
+			n++
+		}
+		cancel()
+		if it.Err() != nil {
+			t.Fatal(it.Err())
+		}
+		if n != total {
+			t.Fatalf("iterated over %d pairs, expected %d", n, total)
+		}
+		if it.Response().Rate.RequestsRemaining != 0 {
+			t.Fatalf("expected 4 pages, rate says %v", it.Response().Rate)
+		}
+	}
+}
+
+// TestPaging walks the pages of a relayer capping per_page, and of one
+// ignoring the page.
+func TestPaging(t *testing.T) {
+	needRelayer(t)
+	defer testRelayer.reset()
+	paging := func(maxPerPage int, ignorePage bool) {
+		testRelayer.mu.Lock()
+		testRelayer.maxPerPage, testRelayer.ignorePage = maxPerPage, ignorePage
+		testRelayer.mu.Unlock()
+	}
+	count := func(it *OrdersIterator) int {
+		n := 0
+		for it.Next() {
+			n++
+		}
+		if it.Err() != nil {
+			t.Fatal(it.Err())
+		}
+		return n
+	}
+	c := NewClient()
+	ctx := context.Background()
+
+	paging(3, false)
+	if n := count(c.AllOrders(ctx, OrdersOpts{PairsOpts: PairsOpts{ListOpts: ListOpts{PerPage: 5}}})); n != 8 {
+		t.Fatalf("got %d of 8 orders with per_page capped", n)
+	}
+
+	paging(0, true)
+	if n := count(c.AllOrders(ctx, OrdersOpts{PairsOpts: PairsOpts{ListOpts: ListOpts{PerPage: 3}}})); n != 3 {
+		t.Fatalf("got %d orders with the page ignored, want the first 3", n)
+	}
+	it := c.AllPairs(ctx, PairsOpts{ListOpts: ListOpts{PerPage: 2}})
+	n := 0
+	for it.Next() {
+		n++
+	}
+	if it.Err() != nil || n != 2 {
+		t.Fatalf("got %d pairs with the page ignored, want the first 2: %v", n, it.Err())
+	}
+}
+
+func TestSignOrder(t *testing.T) {
+	signer, err := NewKeySignerFromHex("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer.Address() != MustHexToAddress("0x2c7536e3605d9c16a7a3d7b1898e529396a65c23") {
+		t.Fatalf("wrong signer address %s", signer.Address())
+	}
+	o := testOrder(t)
+	if err := o.Sign(signer); err == nil {
+		t.Fatal("signing for a different maker should fail")
+	}
+	maker := signer.Address()
+	o.Maker = &maker
+	if err := o.Sign(signer); err != nil {
+		t.Fatal(err)
+	}
+	if !o.Signature.Verify(o.Maker) {
+		t.Fatal("signature doesn't verify")
+	}
+	if o.Signature.Verify(o.Taker) {
+		t.Fatal("signature verifies for a wrong address")
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	for _, c := range []struct {
+		amount   string
+		decimals int
+		want     string
+	}{
+		{"1.5", 18, "1500000000000000000"},
+		{"0.000001", 6, "1"},
+		{"42", 0, "42"},
+		{"0.0000001", 6, ""},
+		{"-1", 18, ""},
+		{"abc", 18, ""},
+	} {
+		i, err := ParseAmount(c.amount, c.decimals)
+		if c.want == "" {
+			if err == nil {
+				t.Fatalf("%s with %d decimals should fail", c.amount, c.decimals)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i.String() != c.want {
+			t.Fatalf("%s with %d decimals: got %s, want %s", c.amount, c.decimals, i, c.want)
+		}
+		if s := FormatAmount(i, c.decimals); s != c.amount {
+			t.Fatalf("%s with %d decimals formats as %s", i, c.decimals, s)
+		}
+	}
+}
+
+func TestProcess(t *testing.T) {
+	ao := APIOrder{
+		MakerToken:       T2A["ZRX"],
+		TakerToken:       T2A["WETH"],
+		MakerTokenAmount: "3000000000000000000",
+		TakerTokenAmount: "1",
+	}
+	bo, err := ao.Process("Ask")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bo.Price.Cmp(big.NewRat(1, 3000000000000000000)) != 0 {
+		t.Fatalf("inexact price %s", bo.Price)
+	}
+	if bo.PriceString(20) != "0.00000000000000000033" || bo.VolumeString() != "3" {
+		t.Fatalf("wrong formatting %s", bo)
+	}
+	bo, err = ao.Process("Bid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bo.PriceFloat() != 3e18 || bo.VolumeString() != "0.000000000000000001" {
+		t.Fatalf("wrong bid %s", bo)
+	}
+	ao.TakerTokenAmount = "0"
+	if _, err := ao.Process("Ask"); err == nil {
+		t.Fatal("zero amount should fail")
+	}
+
+	// 2.5 DGD, which has 9 decimals, for 0.05 WETH
+	ao = APIOrder{
+		MakerToken:       T2A["DGD"],
+		TakerToken:       T2A["WETH"],
+		MakerTokenAmount: "2500000000",
+		TakerTokenAmount: "50000000000000000",
+	}
+	bo, err = ao.Process("Ask")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bo.Price.Cmp(big.NewRat(2, 100)) != 0 || bo.VolumeString() != "2.5" {
+		t.Fatalf("DGD not scaled by its decimals %s", bo)
+	}
+	ao.MakerToken, ao.TakerToken = ao.TakerToken, ao.MakerToken
+	ao.MakerTokenAmount, ao.TakerTokenAmount = ao.TakerTokenAmount, ao.MakerTokenAmount
+	bo, err = ao.Process("Bid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bo.Price.Cmp(big.NewRat(2, 100)) != 0 || bo.VolumeString() != "2.5" {
+		t.Fatalf("DGD bid not scaled by its decimals %s", bo)
+	}
+
+	// 2.5 of a kovan token with 6 decimals for 0.05 WETH
+	kovan := NewTokenRegistry(NetworkKovan)
+	usd, weth := "0x6ff6c0ff1d68b964901f986d4c9fa3ac68346570", "0xd0a1e359811322d97991e03f863a0c30c2cf029c"
+	kovan.Add(TokenInfo{Address: usd, Symbol: "USD", Decimals: 6})
+	kovan.Add(TokenInfo{Address: weth, Symbol: "WETH", Decimals: 18})
+	ao = APIOrder{MakerToken: usd, TakerToken: weth, MakerTokenAmount: "2500000", TakerTokenAmount: "50000000000000000"}
+	bo, err = ao.ProcessWith(kovan, "Ask")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bo.Pair != "USD/WETH" || bo.Price.Cmp(big.NewRat(2, 100)) != 0 || bo.VolumeString() != "2.5" {
+		t.Fatalf("kovan token not scaled by its registry %s", bo)
+	}
+}
+
+func TestOrderBuilder(t *testing.T) {
+	signer, err := NewKeySignerFromHex("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exchange, _ := HexToAddress("0x12459c951127e0c374ff9105dda097662a027093")
+	zrx, _ := HexToAddress(T2A["ZRX"])
+	weth, _ := HexToAddress(T2A["WETH"])
+
+	if _, err := NewOrderBuilder().Exchange(exchange).Build(); err == nil {
+		t.Fatal("building an incomplete order should fail")
+	}
+
+	b := NewOrderBuilder().
+		Exchange(exchange).
+		Maker(signer.Address()).
+		MakerToken(zrx).
+		TakerToken(weth).
+		MakerTokenDecimal("100", 18).
+		TakerTokenDecimal("0.25", 18).
+		TTL(time.Hour)
+	b.now = func() time.Time { return time.Unix(1500000000, 0) }
+	o, err := b.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.ExpirationTimestampInSec.Big().Int64() != 1500003600 {
+		t.Fatalf("wrong expiration %s", o.ExpirationTimestampInSec.Big())
+	}
+	if o.TakerTokenAmount.Big().String() != "250000000000000000" {
+		t.Fatalf("wrong taker amount %s", o.TakerTokenAmount.Big())
+	}
+	if o.Salt.Big().Sign() == 0 {
+		t.Fatal("salt not generated")
+	}
+	o2, _ := b.Build()
+	if *o.Salt == *o2.Salt {
+		t.Fatal("orders built twice share a salt")
+	}
+	if err := o.Sign(signer); err != nil {
+		t.Fatal(err)
+	}
+	if !o.Signature.Verify(o.Maker) {
+		t.Fatal("built order signature doesn't verify")
+	}
+}
+
+func TestValidateOrder(t *testing.T) {
+	signer, err := NewKeySignerFromHex("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exchange := ExchangeAddresses[NetworkMainnet]
+	zrx, _ := HexToAddress(T2A["ZRX"])
+	weth, _ := HexToAddress(T2A["WETH"])
+	o, err := NewOrderBuilder().
+		Exchange(exchange).
+		Maker(signer.Address()).
+		MakerToken(zrx).
+		TakerToken(weth).
+		MakerTokenAmount(big.NewInt(1000)).
+		TakerTokenAmount(big.NewInt(1)).
+		Expiration(time.Unix(1500000000, 0)).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	o.Sign(signer)
+
+	before := func() time.Time { return time.Unix(1400000000, 0) }
+	if ves := o.Validate(ValidateOpts{Now: before}); ves != nil {
+		t.Fatalf("valid order reported invalid: %v", ves)
+	}
+
+	other, _ := HexToAddress("0xa258b39954cef5cb142fd567a46cddb31a670124")
+	*o.Taker = other
+	ves := o.Validate(ValidateOpts{NetworkID: NetworkKovan})
+	fields := []string{}
+	for _, v := range ves {
+		fields = append(fields, v.Field)
+	}
+	want := "exchangeContractAddress taker expirationUnixTimestampSec ecSignature"
+	if strings.Join(fields, " ") != want {
+		t.Fatalf("got violations %v, want fields %s", ves, want)
+	}
+	if ves := o.Validate(ValidateOpts{Now: before, Taker: &other}); len(ves) != 1 || ves[0].Code != CodeInvalidSignature {
+		t.Fatalf("changed order should only fail the signature check: %v", ves)
+	}
+
+	// a signature without a recoverable signer, which isn't logged
+	var logged bytes.Buffer
+	log.SetOutput(&logged)
+	defer log.SetOutput(os.Stderr)
+	*o.Taker = Address{}
+	o.Signature = &Signature{}
+	copy(o.Signature.Hash[:], o.Hash())
+	ves = o.Validate(ValidateOpts{Now: before})
+	if len(ves) != 1 || !strings.HasPrefix(ves[0].Reason, "can't recover the signer: ") {
+		t.Fatalf("unrecoverable signature not reported: %v", ves)
+	}
+	if logged.Len() > 0 {
+		t.Fatalf("validation logged %q", logged.String())
+	}
+}
+
+// testBookOrder builds a ZRX/WETH order, an ask if the maker sells ZRX.
+func testBookOrder(t *testing.T, ask bool, zrx, weth int64, exp time.Time) APIOrder {
+	exchange := ExchangeAddresses[NetworkMainnet]
+	maker, _ := HexToAddress("0x9e56625509c2f60af937f23b7b532600390e8c8b")
+	zrxA, _ := HexToAddress(T2A["ZRX"])
+	wethA, _ := HexToAddress(T2A["WETH"])
+	b := NewOrderBuilder().Exchange(exchange).Maker(maker).Expiration(exp)
+	if ask {
+		b.MakerToken(zrxA).TakerToken(wethA).MakerTokenAmount(big.NewInt(zrx)).TakerTokenAmount(big.NewInt(weth))
+	} else {
+		b.MakerToken(wethA).TakerToken(zrxA).MakerTokenAmount(big.NewInt(weth)).TakerTokenAmount(big.NewInt(zrx))
+	}
+	o, err := b.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs, _ := o.MarshalJSON()
+	ao := APIOrder{}
+	if err := json.Unmarshal(bs, &ao); err != nil {
+		t.Fatal(err)
+	}
+	return ao
+}
+
+func TestLocalBook(t *testing.T) {
+	exp := time.Now().Add(time.Hour)
+	book := NewLocalBook(T2A["ZRX"])
+	err := book.Reset(&Orderbook{
+		Asks: []APIOrder{testBookOrder(t, true, 100, 2, exp), testBookOrder(t, true, 100, 3, exp)},
+		Bids: []APIOrder{testBookOrder(t, false, 100, 1, exp)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ask, ok := book.BestAsk()
+	if !ok || ask.PriceFloat() != 0.02 {
+		t.Fatalf("wrong best ask %v", ask)
+	}
+
+	bid := testBookOrder(t, false, 1000, 15, exp)
+	if _, removed, err := book.Update(bid); err != nil || removed {
+		t.Fatalf("update failed: %v", err)
+	}
+	best, _ := book.BestBid()
+	if best.PriceFloat() != 0.015 {
+		t.Fatalf("wrong best bid %v", best)
+	}
+	bids, asks := book.Depth(0)
+	if len(bids) != 2 || len(asks) != 2 {
+		t.Fatalf("wrong depth %d/%d", len(bids), len(asks))
+	}
+
+	bid.TakerTokenAmountFilled = "600"
+	bid.TakerTokenAmountCancelled = "400"
+	if _, removed, _ := book.Update(bid); !removed {
+		t.Fatal("filled order not removed")
+	}
+	if bo, removed, err := book.Update(bid); bo != nil || removed || err != nil {
+		t.Fatalf("removed an order twice: %v %v", bo, err)
+	}
+	unknown := testBookOrder(t, true, 100, 7, exp)
+	unknown.TakerTokenAmountCancelled = "7"
+	if bo, removed, err := book.Update(unknown); bo != nil || removed || err != nil {
+		t.Fatalf("removed an unknown order: %v %v", bo, err)
+	}
+	if best, _ := book.BestBid(); best.PriceFloat() != 0.01 {
+		t.Fatalf("wrong best bid after fill %v", best)
+	}
+
+	book.now = func() time.Time { return exp }
+	if _, ok := book.BestAsk(); ok {
+		t.Fatal("expired orders still in book")
+	}
+
+	// subscriptions may use checksummed addresses
+	book = NewLocalBook(MustHexToAddress(T2A["ZRX"]).Hex())
+	if _, _, err := book.Update(testBookOrder(t, true, 100, 2, time.Now().Add(time.Hour))); err != nil {
+		t.Fatal(err)
+	}
+	if ask, ok := book.BestAsk(); !ok || ask.PriceFloat() != 0.02 {
+		t.Fatalf("ask of a checksummed base token filed as bid, best ask %v", ask)
+	}
+
+	// ZRX and WETH with 2 decimals
+	r := NewTokenRegistry(NetworkMainnet)
+	r.Add(TokenInfo{Address: T2A["ZRX"], Symbol: "ZRX", Decimals: 2})
+	r.Add(TokenInfo{Address: T2A["WETH"], Symbol: "WETH", Decimals: 2})
+	book = NewLocalBookWithTokens(T2A["ZRX"], r)
+	bo, _, err := book.Update(testBookOrder(t, true, 100, 2, time.Now().Add(time.Hour)))
+	if err != nil || bo.PriceFloat() != 0.02 || bo.VolumeString() != "1" {
+		t.Fatalf("local book ignores its registry %v: %v", bo, err)
+	}
+}
+
+func TestTokenRegistry(t *testing.T) {
+	zrx, err := DefaultTokens.BySymbol("zrx")
+	if err != nil || zrx.Address != T2A["ZRX"] || zrx.NetworkID != NetworkMainnet {
+		t.Fatalf("wrong ZRX %v: %v", zrx, err)
+	}
+	if d := DefaultTokens.Decimals(T2A["DGD"]); d != 9 {
+		t.Fatalf("DGD has %d decimals, want 9", d)
+	}
+	_, err = DefaultTokens.BySymbol("BTL")
+	ae, ok := err.(*AmbiguousSymbolError)
+	if !ok || len(ae.Tokens) != 2 || ae.Tokens[0].Name == "" {
+		t.Fatalf("expected BTL to be ambiguous, got %v", err)
+	}
+
+	r := NewTokenRegistry(NetworkKovan)
+	err = r.LoadJSON(strings.NewReader(`[
+		{"address": "0x6FF6C0FF1D68B964901F986D4C9FA3AC68346570", "symbol": "ZRX", "name": "0x Protocol Token"},
+		{"address": "0xd0a1e359811322d97991e03f863a0c30c2cf029c", "symbol": "WETH", "decimals": 18, "networkId": 42},
+		{"address": "0x3b1d6ba4f0e5e4e0fa3f1fa5b6d84bb5e8d70401", "symbol": "USD", "decimals": 6}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok, ok := r.ByAddress("0x6ff6c0ff1d68b964901f986d4c9fa3ac68346570"); !ok || tok.Decimals != 18 || tok.NetworkID != NetworkKovan {
+		t.Fatalf("wrong token %v", tok)
+	}
+	if err := r.Add(TokenInfo{Address: "0x3b1d6ba4f0e5e4e0fa3f1fa5b6d84bb5e8d70401", Symbol: "USDX", Decimals: 6}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.BySymbol("USD"); err != ErrTokenNotFound {
+		t.Fatalf("renamed token still found by its old symbol: %v", err)
+	}
+	if err := r.Add(TokenInfo{Address: T2A["ZRX"], NetworkID: NetworkMainnet}); err == nil {
+		t.Fatal("added a mainnet token to a kovan registry")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			r.Add(TokenInfo{Address: fmt.Sprintf("0x%040x", i+1), Symbol: "DUP"})
+			r.AllBySymbol("DUP")
+		}(i)
+	}
+	wg.Wait()
+	if n := len(r.AllBySymbol("dup")); n != 4 {
+		t.Fatalf("got %d DUP tokens, want 4", n)
+	}
+
+	needRelayer(t)
+	r = NewTokenRegistry(NetworkMainnet)
+	if err := r.LoadPairs(context.Background(), NewClient()); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(r.Tokens()); n != 4 {
+		t.Fatalf("loaded %d tokens from the pairs, want 4", n)
+	}
+}
+
+func TestAddress(t *testing.T) {
+	// EIP-55 test vectors
+	for _, s := range []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+	} {
+		for _, in := range []string{s, strings.ToLower(s), "0X" + strings.ToUpper(s[2:]), s[2:]} {
+			a, err := HexToAddress(in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if a.Hex() != s || a.String() != s {
+				t.Fatalf("%s: got checksum %s, want %s", in, a.Hex(), s)
+			}
+		}
+	}
+	for _, s := range []string{"", "0x", "0x1234", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAedaa", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg"} {
+		if _, err := HexToAddress(s); err == nil {
+			t.Fatalf("parsed malformed address %q", s)
+		}
+	}
+
+	zrx := MustHexToAddress(T2A["ZRX"])
+	bs, err := json.Marshal(zrx)
+	if err != nil || string(bs) != `"`+T2A["ZRX"]+`"` {
+		t.Fatalf("wrong JSON %s: %v", bs, err)
+	}
+	m := map[Address]Address{}
+	if err := json.Unmarshal([]byte(`{"`+zrx.Hex()+`": "`+strings.ToUpper(T2A["WETH"][2:])+`"}`), &m); err != nil {
+		t.Fatal(err)
+	}
+	if m[zrx] != MustHexToAddress(T2A["WETH"]) {
+		t.Fatalf("wrong JSON round trip %v", m)
+	}
+	a := Address{}
+	if err := json.Unmarshal([]byte(`"0x1234"`), &a); err == nil {
+		t.Fatal("unmarshalled a short address")
+	}
+	if err := json.Unmarshal([]byte(`1234`), &a); err == nil {
+		t.Fatal("unmarshalled a number into an address")
+	}
+	fr := FeesResponse{FeeRecipient: zrx}
+	if err := json.Unmarshal([]byte(`{"feeRecipient": null}`), &fr); err != nil || fr.FeeRecipient != zrx {
+		t.Fatalf("null changed the address to %s: %v", fr.FeeRecipient, err)
+	}
+
+	v, err := zrx.Value()
+	if err != nil || v != T2A["ZRX"] {
+		t.Fatalf("wrong SQL value %v: %v", v, err)
+	}
+	for _, src := range []interface{}{v, []byte(zrx.Hex()), zrx[:]} {
+		a := Address{}
+		if err := a.Scan(src); err != nil || a != zrx {
+			t.Fatalf("scanned %v from %v: %v", a, src, err)
+		}
+	}
+	if err := a.Scan(42); err == nil {
+		t.Fatal("scanned an int into an address")
+	}
+
+	q, err := query.Values(OrdersOpts{MakerToken: zrx, PairsOpts: PairsOpts{TokenB: zrx}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := q.Encode(); e != "makerTokenAddress="+T2A["ZRX"]+"&tokenB="+T2A["ZRX"] {
+		t.Fatalf("wrong query %s", e)
+	}
+	if _, _, err := NewClient().Orderbook(OrderbookOpts{BaseTokenAddress: zrx}); err == nil {
+		t.Fatal("requested an orderbook without the quote token")
+	}
 }
@@ -0,0 +1,74 @@
+package rrgo
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Signer signs order hashes on behalf of an address.
+type Signer interface {
+	Address() Address
+	// SignHash returns the signature of the Ethereum personal message
+	// containing hash.
+	SignHash(hash []byte) (*Signature, error)
+}
+
+// KeySigner is a Signer holding a private key in memory.
+type KeySigner struct {
+	key     *ecdsa.PrivateKey
+	address Address
+}
+
+func NewKeySigner(key *ecdsa.PrivateKey) *KeySigner {
+	return &KeySigner{
+		key:     key,
+		address: Address(crypto.PubkeyToAddress(key.PublicKey)),
+	}
+}
+
+// NewKeySignerFromHex creates a KeySigner from a hex encoded private key.
+func NewKeySignerFromHex(hexKey string) (*KeySigner, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	return NewKeySigner(key), nil
+}
+
+func (s *KeySigner) Address() Address {
+	return s.address
+}
+
+func (s *KeySigner) SignHash(hash []byte) (*Signature, error) {
+	sig, err := crypto.Sign(personalMessageHash(hash), s.key)
+	if err != nil {
+		return nil, err
+	}
+	signature := &Signature{V: sig[64] + 27}
+	copy(signature.R[:], sig[0:32])
+	copy(signature.S[:], sig[32:64])
+	copy(signature.Hash[:], hash)
+	return signature, nil
+}
+
+// personalMessageHash hashes hash as an Ethereum signed message, the way
+// wallets do for eth_sign.
+func personalMessageHash(hash []byte) []byte {
+	return crypto.Keccak256([]byte("\x19Ethereum Signed Message:\n32"), hash)
+}
+
+// Sign signs the order with s, which has to act for the order's maker.
+func (order *Order) Sign(s Signer) error {
+	if *order.Maker != s.Address() {
+		return fmt.Errorf("signer %s is not the maker %s", s.Address(), *order.Maker)
+	}
+	sig, err := s.SignHash(order.Hash())
+	if err != nil {
+		return err
+	}
+	order.Signature = sig
+	return nil
+}
@@ -1,996 +1,1036 @@
+// Code generated by gentokens; DO NOT EDIT.
+
 package rrgo
 
-var (
-	A2T = map[string]string{
+// A2T maps token addresses to their symbols.
+var A2T = map[string]string{
+	"0x006bea43baa3f7a6f765f14f10a1a1b08334ef45": "STX",
+	"0x009e864923b49263c7f10d19b7f8ab7a9a5aad33": "FKX",
+	"0x014b50466590340d41307cc54dcee990c8d58aa8": "ICOS",
+	"0x01b3ec4aae1b8729529beb4965f27d008788b0eb": "DPP",
+	"0x025abad9e518516fdaafbdcdb9701b37fb7ef0fa": "GTKT",
+	"0x056017c55ae7ae32d12aef7c679df83a85ca75ff": "WYV",
+	"0x05f4a42e251f2d52b8ed15e9fedaacfcef1fad27": "ZIL",
+	"0x06012c8cf97bead5deae237070f9587f8e7a266d": "CK",
+	"0x07d9e49ea402194bf48a8276dafb16e4ed633317": "DALC",
+	"0x07e3c70653548b04f0a75970c1f81b4cbbfb606f": "DLT",
+	"0x080aa07e2c7185150d7e4da98838a8d2feac3dfc": "BTT",
+	"0x08711d3b02c8758f2fb3ab4e80228418a7f8e39c": "EDG",
+	"0x0886949c1b8c412860c4264ceb8083d1365e86cf": "BTCE",
+	"0x08d32b0da63e2c3bcf8019c9c5d849d7a9d791e6": "DCN",
+	"0x08f5a9235b08173b7569f83645d2c7fb55e8ccd8": "TNT",
+	"0x0996bfb5d057faa237640e2506be7b4f9c46de0b": "RNDR",
+	"0x0aaf561eff5bd9c8f911616933f84166a17cfe0c": "JBX",
+	"0x0abdace70d3790235af448c88547603b945604ea": "DNT",
+	"0x0abefb7611cb3a01ea3fad85f33c3c934f8e2cf4": "FRD",
+	"0x0aef06dcccc531e581f0440059e6ffcc206039ee": "ITT",
+	"0x0af44e2784637218dd1d32a322d44e603a8f0c6a": "MTX",
+	"0x0affa06e7fbe5bc9a764c979aa66e8256a631f02": "PLBT",
+	"0x0c04d4f331da8df75f9e2e271e3f3f1494c66c36": "PRSP",
+	"0x0cf0ee63788a0849fe5297f3407f701e122cc023": "DATACoin",
+	"0x0d8775f648430679a709e98d2b0cb6250d2887ef": "BAT",
+	"0x0d88ed6e74bbfd96b831231638b66c05571e824f": "AVT",
+	"0x0e0989b1f9b8a38983c2ba8053269ca62ec9b195": "POE",
+	"0x0f33bb20a282a7649c7b3aff644f084a9348e933": "YUPIE",
+	"0x0f513ffb4926ff82d7f60a05069047aca295c413": "XSC",
+	"0x0f5d2fb29fb7d3cfee444a200298f468908cc942": "MANA",
+	"0x103c3a209da59d3e7c4a89307e66521e081cfdf0": "GVT",
+	"0x1063ce524265d5a3a624f4914acd573dd89ce988": "AIX",
+	"0x107c4504cd79c5d2696ea0030a8dd4e92601b82e": "BLT",
+	"0x10b123fddde003243199aad03522065dc05827a0": "SYN",
+	"0x1234567461d3f8db7496581774bd869c83d51c93": "CAT (BitClave)",
+	"0x1245ef80f4d9e02ed9425375e8f649b9221b31d8": "ARCT",
+	"0x12480e24eb5bec1a9d4369cab6a80cad3c0a377a": "SUB",
+	"0x12b19d3e2ccc14da04fae33e63652ce469b3f2fd": "GRID",
+	"0x12b306fa98f4cbb8d4457fdff3a0a0a56f07ccdf": "SXDT",
+	"0x12fef5e57bf45873cd9b62e9dbd7bfb99e32d73e": "CFI",
+	"0x138a8752093f4f9a79aaedf48d4b9248fab93c9c": "MCI",
+	"0x13f11c9905a08ca76e3e853be63d4f0944326c72": "DIVX",
+	"0x13f1b7fdfbe1fc66676d56483e21b1ecb40b58e2": "ACC",
+	"0x14f37b574242d366558db61f3335289a5035c506": "HKG",
+	"0x151202c9c18e495656f372281f493eb7698961d5": "DEB",
+	"0x163733bcc28dbf26b41a8cfa83e369b5b3af741b": "PRS",
+	"0x16662f73df3e79e54c6c5938b4313f92c524c120": "IIC",
+	"0x16b0e62ac13a2faed36d18bce2356d25ab3cfad3": "BTQ",
+	"0x17052d51e954592c1046320c2371abab6c73ef10": "ATH",
+	"0x1776e1f26f98b1a5df9cd347953a26dd3cb46671": "NMR",
+	"0x177d39ac676ed1c67a2b268ad7f1e58826e5b0af": "CDT",
+	"0x17f93475d2a978f527c3f7c44abf44adfba60d5c": "ECO2",
+	"0x181a63746d3adcf356cbc73ace22832ffbb1ee5a": "ALCO",
+	"0x1844b21593262668b7248d0f57a220caaba46ab9": "PRL",
+	"0x190e569be071f40c704e15825f285481cb74b6cc": "FAM",
+	"0x1961b3331969ed52770751fc718ef530838b6dee": "BDG",
+	"0x1a7a8bd9106f2b8d977e08582dc7d24c723ab0db": "APPC",
+	"0x1a95b271b0535d15fa49932daba31ba612b52946": "MNE",
+	"0x1b5f21ee98eed48d292e8e2d3ed82b40a9728a22": "DATABroker",
+	"0x1b9743f556d65e757c4c650b4555baf354cb8bd3": "ETBS",
+	"0x1c4481750daa5ff521a2a7490d9981ed46465dbd": "BCPT",
+	"0x1d462414fe14cf489c7a21cac78509f4bf8cd7c0": "CAN",
+	"0x1e09bd8cadb441632e441db3e1d79909ee0a2256": "DSC",
+	"0x1e49ff77c355a3e38d6651ce8404af0e48c5395f": "MTRc",
+	"0x1e797ce986c3cff4472f7d38d5c4aba55dfefe40": "BCDN",
+	"0x1ec8fe51a9b6a3a6c427d17d9ecc3060fbc4a45c": "S-A-PAT",
+	"0x1f54638b7737193ffd86c19ec51907a7c41755d8": "SOL",
+	"0x1f573d6fb3f13d689ff844b4ce37794d79a7ff1c": "BNT",
+	"0x2023dcf7c438c8c8c0b0f28dbae15520b4f3ee20": "FTR",
+	"0x2108e62d335bbdc89ec3e9d8582f18dcfb0cdff4": "CARCO",
+	"0x2134057c0b461f898d375cead652acae62b59541": "CXC",
+	"0x21ae23b882a340a22282162086bc98d3e2b73018": "LOK",
+	"0x21f0f0fd3141ee9e11b3d7f13a1028cd515f459c": "MRP",
+	"0x226bb599a12c826476e3a771454697ea52e9e220": "PRO",
+	"0x22e5f62d0fa19974749faa194e3d3ef6d89c08d7": "IMT",
+	"0x22f0af8d78851b72ee799e05f54a77001586b18a": "GXVC",
+	"0x23ae3c5b39b12f0693e05435eeaa1e51d8c61530": "APT",
+	"0x24083bb30072643c3bb90b44b7285860a755e687": "GELD",
+	"0x24692791bc444c5cd0b81e3cbcaba4b04acd1f3b": "UKG",
+	"0x24a77c1f17c547105e14813e517be06b0040aa76": "LIVE",
+	"0x24aef3bf1a47561500f9430d74ed4097c47f51f2": "SPARTA",
+	"0x255aa6df07540cb5d3d297f0d0d4d84cb52bc8e6": "RDN",
+	"0x26e75307fc0c021472feb8f727839531f112f317": "C20",
+	"0x27054b13b1b798b345b591a4d22e6562d47ea75a": "AST",
+	"0x27695e09149adc738a978e9a678f99e4c39e9eb9": "KICK",
+	"0x27dce1ec4d3f72c3e457cc50354f1f975ddef488": "AIR",
+	"0x28577a6d31559bd265ce3adb62d0458550f7b8a7": "CCC (CryptoCrashCourse)",
+	"0x286bda1413a2df81731d4930ce2f862a35a609fe": "WaBi",
+	"0x2a3aa9eca41e720ed46b5a70d6c37efa47f768ac": "RCT",
+	"0x2accab9cb7a48c3e82286f0b2f8798d201f4ec3f": "BTL (Battle)",
+	"0x2bdc0d42996017fce214b21607a515da41a9e0c5": "SKIN",
+	"0x2c3c1f05187dba7a5f2dd47dca57281c4d4f183f": "QTQ",
+	"0x2c4e8f2d746113d0696ce89b35f0d8bf88e0aeca": "OST",
+	"0x2c82c73d5b34aa015989462b2948cd616a37641f": "SXUT",
+	"0x2c974b2d0ba1716e644c1fc59982a89ddd2ff724": "VIB",
+	"0x2ccbff3a042c68716ed2a2cb0c544a9f1d1935e1": "DMT",
+	"0x2dcfaac11c9eebd8c6c42103fe9e2a6ad237af27": "SMT",
+	"0x2e071d2966aa7d8decb1005885ba1977d6038a65": "DICE",
+	"0x2eb86e8fc520e0f6bb5d9af08f924fe70558ab89": "LGR",
+	"0x2ef1ab8a26187c58bb8aaeb11b2fc6d25c5c0716": "TWN",
+	"0x30f4a3e0ab7a76733d8b60b89dd93c3d0b4c9e2f": "XGT",
+	"0x3136ef851592acf49ca4c825131e364170fa32b3": "COFI",
+	"0x315ce59fafd3a8d562b7ec1c8542382d2710b06c": "CCS",
+	"0x327682779bab2bf4d1337e8974ab9de8275a7ca8": "BPT",
+	"0x340d2bde5eb28c1eed91b2f790723e3b160613b7": "VEE",
+	"0x3597bfd533a99c9aa083587b074434e61eb0a258": "DENT",
+	"0x3618516f45cd3c913f81f9987af41077932bc40d": "PCL",
+	"0x386467f1f3ddbe832448650418311a479eecfc57": "MBRS",
+	"0x386faa4703a34a7fdb19bec2e14fd427c9638416": "DCA",
+	"0x399a0e6fbeb3d74c85357439f4c8aed9678a5cbf": "DCL",
+	"0x39bb259f66e1c59d5abef88375979b4d20d98022": "WAX",
+	"0x3a1bda28adb5b0a812a7cf10a1950c920f79bcd3": "FLP",
+	"0x3a26746ddb79b1b8e4450e3f4ffe3285a307387e": "ETHB",
+	"0x3c75226555fc496168d48b88df83b95f16771f37": "DROP (droplex)",
+	"0x3d1ba9be9f66b8ee101911bc36d3fb562eac2244": "RVT",
+	"0x3eb91d237e491e0dee8582c402d85cb440fb6b54": "S-ETH",
+	"0x3edd235c3e840c1f29286b2e39370a255c7b6fdb": "CMBT",
+	"0x3f4b726668da46f5e0e75aa5d478acec9f38210f": "M-ETH",
+	"0x40395044ac3c0c57051906da938b54bd6557f212": "MGO",
+	"0x408e41876cccdc0f92210600ef50372656052a38": "REN",
+	"0x4156d3342d5c385a87d264f90653733592000581": "SALT",
+	"0x4162178b78d6985480a308b2190ee5517460406d": "CLN",
+	"0x419c4db4b9e25d6db2ad9691ccb832c8d9fda05e": "DRGN",
+	"0x419d0d8bdd9af5e606ae2232ed285aff190e711b": "FUN",
+	"0x41dbecc1cdc5517c6f76f6a6e836adbee2754de3": "MTN",
+	"0x41e5560054824ea6b0732e656e3ad64e20e94e45": "CVC",
+	"0x41f615e24fabd2b097a320e9e6c1f448cb40521c": "RVL",
+	"0x422866a8f0b032c5cf1dfbdef31a20f4509562b0": "ADST",
+	"0x423e4322cdda29156b49a17dfbd2acc4b280600d": "CAR",
+	"0x42d6622dece394b54999fbd73d108123806f6a18": "SPANK",
+	"0x4355fc160f74328f9b383df2ec589bb3dfd82ba0": "OPT",
+	"0x43f6a1be992dee408721748490772b15143ce0a7": "POIN",
+	"0x44197a4c44d6a059297caf6be4f7e172bd56caaf": "ELTCOIN",
+	"0x4470bb87d77b963a013db939be332f927f2b992e": "ADX",
+	"0x44f588aeeb8c44471439d1270b3603c66a9262f1": "SNIP",
+	"0x4545750f39af6be4f237b6869d4ecca928fd5a85": "CTF",
+	"0x45e42d659d9f9466cd5df622506033145a9b89bc": "NxC",
+	"0x46492473755e8df960f8034877f61732d718ce96": "STRC",
+	"0x4672bad527107471cb5067a887f4656d585a8a31": "DROP (dropil)",
+	"0x4993cb95c7443bdc06155c5f5688be9d8f6999a5": "ROUND",
+	"0x4994e81897a920c0fea235eb8cedeed3c6fff697": "SKO1",
+	"0x4a42d2c580f83dce404acad18dab26db11a1750e": "RLX",
+	"0x4c382f8e09615ac86e08ce58266cc227e7d4d913": "SKR",
+	"0x4ca74185532dc1789527194e5b9c866dd33f4e82": "SenSatorI",
+	"0x4cc19356f2d37338b9802aa8e8fc58b0373296e7": "KEY",
+	"0x4ceda7906a5ed2179785cd3a40a69ee8bc99c466": "AION",
+	"0x4cf488387f035ff08c371515562cba712f9015d4": "WPR",
+	"0x4d829f8c92a6691c56300d020c9e0db984cfe2ba": "XCC",
+	"0x4d8fc1453a0f359e99c9675954e656d80d996fbf": "BEE",
+	"0x4dc3643dbc642b72c158e7f3d2ff232df61cb6ce": "AMB",
+	"0x4df47b4969b2911c966506e3592c41389493953b": "FND",
+	"0x4df812f6064def1e5e029f1ca858777cc98d2d81": "XAUR",
+	"0x4e0603e2a27a30480e5e3a4fe548e29ef12f64be": "CREDO",
+	"0x4f4f0db4de903b88f2b1a2847971e231d54f8fd3": "GEE",
+	"0x509a38b7a1cc0dcd83aa9d06214663d9ec7c7f4a": "BST",
+	"0x514910771af9ca656af840dff83e8264ecf986ca": "LINK (Chainlink)",
+	"0x519475b31653e46d20cd09f9fdcf3b12bdacb4f5": "VIU",
+	"0x51db5ad35c671a87207d88fc11d593ac0c8415bd": "MDA",
+	"0x53148bb4551707edf51a1e8d7a93698d18931225": "PCLOLD",
+	"0x533ef0984b2faa227acc620c67cce12aa39cd8cd": "XGM",
+	"0x54b293226000ccbfc04df902eec567cb4c35a903": "RTN",
+	"0x5512e1d6a7be424b4323126b4f9e86d023f95764": "PTWO",
+	"0x554c20b7c486beee439277b4540a434566dc4c02": "HST",
+	"0x55648de19836338549130b1af587f16bea46f66b": "PBL",
+	"0x55b9a11c2e8351b4ffc7b11561148bfac9977855": "DGX",
+	"0x55c2a0c171d920843560594de3d6eecc09efc098": "PEXT",
+	"0x566fd7999b1fc3988022bd38507a48f0bcf22c77": "TRCN",
+	"0x56ba2ee7890461f463f7be02aac3099f6d5811a8": "CAT (Blockcat)",
+	"0x572e6f318056ba0c5d47a422653113843d250691": "XNT",
+	"0x5884969ec0480556e11d119980136a4c17edded1": "PET",
+	"0x58bf7df57d9da7113c4ccb49d8463d4908c735cb": "SPARC",
+	"0x58ca3065c0f24c7c96aee8d6056b5b5decf9c2f8": "GXC",
+	"0x59416a25628a76b4730ec51486114c32e0b582a1": "PLASMA",
+	"0x595832f8fc6bf59c85c527fec3740a1b7a361269": "POWR",
+	"0x599346779e90fc3f5f997b5ea715349820f91571": "STN",
+	"0x5a84969bb663fb64f6d015dcf9f622aedc796750": "ICE",
+	"0x5af2be193a6abca9c8817001f45744777db30756": "BQX",
+	"0x5b26c5d0772e5bbac8b3182ae9a13f9bb2d03765": "EDU",
+	"0x5b2e4a700dfbc560061e957edec8f6eeeb74a320": "INS",
+	"0x5c543e7ae0a1104f78406c340e9c64fd9fce5170": "VSL",
+	"0x5c6183d10a00cd747a6dbb5f658ad514383e9419": "NXX OLD",
+	"0x5ca9a71b1d01849c0a95490cc00559717fcf0d1d": "AE",
+	"0x5e3346444010135322268a4630d2ed5f8d09446c": "LOC",
+	"0x5e4abe6419650ca839ce5bb7db422b881a6064bb": "WiC",
+	"0x5e6b6d9abad9093fdc861ea1600eba1b355cd940": "ITC",
+	"0x5f53f7a8075614b699baad0bc2c899f4bad8fbbf": "REBL",
+	"0x607f4c5bb672230e8672085532f7e901544a7375": "RLC",
+	"0x62087245087125d3db5b9a3d713d78e7bbc31e54": "WPC",
+	"0x621d78f2ef2fd937bfca696cabaf9a779f59b3ed": "DRP",
+	"0x629aee55ed49581c33ab27f9403f7992a289ffd5": "STC",
+	"0x62cd07d414ec50b68c7ecaa863a23d344f2d062f": "WIC",
+	"0x638ac149ea8ef9a1286c41b977017aa7359e6cfa": "ALTS",
+	"0x63e634330a20150dbb61b15648bc73855d6ccf07": "LNC",
+	"0x6425c6be902d692ae2db752b3c268afadb099d3b": "MWAT",
+	"0x64cdf819d3e75ac8ec217b3496d7ce167be42e80": "IPL",
+	"0x65292eeadf1426cd2df1c4793a3d7519f253913b": "COSS",
+	"0x6531f133e6deebe7f2dce5a0441aa7ef330b4e53": "TIME",
+	"0x65a15014964f2102ff58647e16a16a6b9e14bcf6": "Ox Fina",
+	"0x662abcad0b7f345ab7ffb1b1fbb9df7894f18e66": "CTX",
+	"0x66497a283e0a007ba3974e837784c6ae323447de": "PT",
+	"0x667088b212ce3d06a1b553a7221e1fd19000d9af": "WINGS",
+	"0x671abbe5ce652491985342e85428eb1b07bc6c64": "QAU",
+	"0x672a1ad4f667fb18a333af13667aa0af1f5b5bdd": "CRED",
+	"0x6745fab6801e376cd24f03572b9c9b0d4edddccf": "SENSE",
+	"0x6781a0f84c7e9e846dcb84a9a5bd49333067b104": "ZAP",
+	"0x6810e776880c02933d47db1b9fc05908e5386b96": "GNO",
+	"0x68aa3f232da9bdc2343465545794ef3eea5209bd": "MSP",
+	"0x68d57c9a1c35f63e2c83ee8e49a64e9d70528d25": "SRN",
+	"0x68e14bb5a45b9681327e16e528084b9d962c1a39": "CATs (BitClave)_Old",
+	"0x694404595e3075a942397f466aacd462ff1a7bd0": "PATENTS",
+	"0x697beac28b09e122c4332d163985e8a73121b97f": "QRL",
+	"0x6a0a97e47d15aad1d132a1ac79a480e3f2079063": "WCT",
+	"0x6beb418fc6e1958204ac8baddcf109b8e9694966": "LNC-Linker Coin",
+	"0x6e34d8d84764d40f6d7b39cd569fd017bf53177d": "SKRP",
+	"0x6f6deb5db0c4994a8283a01d6cfeeb27fc3bbe9c": "SMART",
+	"0x6fff3806bbac52a20e0d79bc538d527f6a22c96b": "CDX",
+	"0x701c244b988a513c945973defa05de933b23fe1d": "OAX",
+	"0x708876f486e448ee89eb332bfbc8e593553058b9": "GAVEL",
+	"0x70a72833d6bf7f508c8224ce59ea1ef3d0ea3a38": "UTK",
+	"0x70b147e01e9285e7ce68b9ba437fe3a9190e756a": "FLX",
+	"0x71e8d74ff1c923e369d0e70dfb09866629c4dd35": "WRK",
+	"0x728781e75735dc0962df3a51d7ef47e798a7107e": "WOLK",
+	"0x72d32ac1c5e66bfc5b08806271f8eef915545164": "KEE",
+	"0x72dd4b6bd852a3aa172be4d6c5a6dbec588cf131": "NGC",
+	"0x7367a68039d4704f30bfbf6d948020c3b07dfc59": "BCBC",
+	"0x73dd069c299a5d691e9836243bcaec9c8c1d8734": "BTE",
+	"0x744d70fdbe2ba4cf95131626614a1763df805b9e": "SNT",
+	"0x74951b677de32d596ee851a233336926e6a2cd09": "WBA",
+	"0x74c1e4b8cae59269ec1d85d3d4f324396048f4ac": "BeerCoin",
+	"0x7585f835ae2d522722d2684323a0ba83401f32f5": "GBT",
+	"0x75aa7b0d02532f3833b66c7f0ad35376d373ddf8": "ARD",
+	"0x7627de4b93263a6a7570b8dafa64bae812e5c394": "NXX",
+	"0x7641b2ca9ddd58addf6e3381c1f994aac5f1a32f": "PRPS",
+	"0x7654915a1b82d6d2d0afc37c52af556ea8983c7e": "IFT",
+	"0x767ba2915ec344015a7938e3eedfec2785195d05": "REA",
+	"0x7705faa34b16eb6d77dfc7812be2367ba6b0248e": "ARX",
+	"0x7728dfef5abd468669eb7f9b48a7f70a501ed29d": "PRG",
+	"0x773450335ed4ec3db45af74f34f2c85348645d39": "JetCoins",
+	"0x779b7b713c86e3e6774f5040d9ccc2d43ad375f8": "POOL",
+	"0x78b7fada55a64dd895d8c8c35779dd8b67fa8a05": "ATL",
+	"0x78fe18e41f436e1981a3a60d1557c8a7a9370461": "SCANDI",
+	"0x7a5ff295dc8239d5c2374e4d894202aaf029cab6": "SLT",
+	"0x7c5a0ce9267ed19b22f8cae653f198e3e8daf098": "SAN",
+	"0x7d4b8cce0591c9044a22ee543533b72e976e36c3": "CAG",
+	"0x7dd7f56d697cc0f2b52bd55c057f378f1fe6ab4b": "$TEAK",
+	"0x7e667525521cf61352e2e01b50faaae7df39749a": "CMC",
+	"0x7f1e2c7d6a69bf34824d72c53b4550e895c0d8c2": "BOP",
+	"0x7f2176ceb16dcb648dc924eff617c3dc2befd30d": "OHNI",
+	"0x7f585b9130c64e9e9f470b618a7badd03d79ca7e": "CR7",
+	"0x7fc408011165760ee31be2bf20daf450356692af": "MTR",
+	"0x7fce2856899a6806eeef70807985fc7554c66340": "CLP",
+	"0x80a7e048f37a50500351c204cb407766fa3bae7f": "CRPT",
+	"0x80bc5512561c7f85a3a9508c7df7901b370fa1df": "TIO",
+	"0x814964b1bceaf24e26296d031eadf134a2ca4105": "NEWB",
+	"0x814cafd4782d2e728170fda68257983f03321c58": "IDEA",
+	"0x818fc6c2ec5986bc6e2cbf00939d90556ab12ce5": "KIN",
+	"0x81c9151de0c8bafcd325a57e3db5a5df1cebf79c": "DAT",
+	"0x83cee9e086a77e492ee0bb93c2b0437ad6fdeccc": "MNTP",
+	"0x83eea00d838f92dec4d1475697b9f4d3537b56e3": "VOISE",
+	"0x84543f868ec1b1fac510d49d13c069f64cd2d5f9": "Hdp.ф",
+	"0x85089389c14bd9c77fc2b8f0c3d1dc3363bf06ef": "SPF",
+	"0x85e076361cc813a908ff672f9bad1541474402b2": "TEL",
+	"0x86fa049857e0209aa7d9e616f7eb3b3b78ecfdb0": "EOS",
+	"0x8727c112c712c4a03371ac87a74dd6ab104af768": "JET",
+	"0x8810c63470d38639954c6b41aac545848c46484a": "ADI",
+	"0x882448f83d90b2bf477af2ea79327fdea1335d93": "VIBEX",
+	"0x887834d3b8d450b6bab109c252df3da286d73ce4": "ATT",
+	"0x888666ca69e0f178ded6d75b5726cee99a87d698": "ICN",
+	"0x88a3e4f35d64aad41a6d4030ac9afe4356cb84fa": "PRE",
+	"0x88ae96845e157558ef59e9ff90e766e22e480390": "IKB",
+	"0x88fcfbc22c6d3dbaa25af478c578978339bde77a": "FYN",
+	"0x89205a3a3b2a69de6dbf7f01ed13b2108b2c43e7": "Unicorn",
+	"0x89d24a6b4ccb1b6faa2625fe562bdd9a23260359": "DAI",
+	"0x8a187d5285d316bcbc9adafc08b51d70a0d8e000": "SIFT",
+	"0x8a95ca448a52c0adf0054bb3402dc5e09cd6b232": "CDL",
+	"0x8aa33a7899fcc8ea5fbe6a608a109c3893a1b8b2": "BET",
+	"0x8ae4bf2c33a8e667de34b54938b0ccd03eb8cc06": "PTOY",
+	"0x8c65e992297d5f092a756def24f4781a280198ff": "GZE",
+	"0x8eb24319393716668d768dcec29356ae9cffe285": "AGI",
+	"0x8effd494eb698cc399af6231fccd39e08fd20b15": "PIX",
+	"0x8f3470a7388c05ee4e7af3d01d8c722b0ff52374": "VERI",
+	"0x8f8221afbb33998d8584a2b05749ba73c37a938a": "REQ",
+	"0x9002d4485b7594e3e850f0a206713b305113f69e": "HAT",
+	"0x910dfc18d6ea3d6a7124a6f8b5458f281060fa4c": "X8X",
+	"0x923108a439c4e8c2315c4f6521e5ce95b44e9b4c": "EVE",
+	"0x92685e93956537c25bb75d5d47fca4266dd628b8": "BTL (Bitlle)",
+	"0x93e682107d1e9defb0b5ee701c71707a4b2e46bc": "MCAP",
+	"0x949bed886c739f1a3273629b3320db0c5024c719": "AMIS",
+	"0x9541fd8b9b5fa97381783783cebf2f5fa793c262": "KZN",
+	"0x954b5de09a55e59755acbda29e1eb74a45d30175": "FLUZ",
+	"0x957c30ab0426e0c93cd8241e2c60392d08c6ac8e": "MOD",
+	"0x95daaab98046846bf4b2853e23cba236fa394a31": "EMONT",
+	"0x960b236a07cf122663c4303350609a66a7b288c0": "ANT",
+	"0x983f6d60db79ea8ca4eb9968c6aff8cfa04b3c63": "SNM",
+	"0x986ee2b944c42d017f52af21c4c69b84dbea35d8": "BMX",
+	"0x98f5e9b7f0e33956c0443e81bf7deb8b5b1ed545": "SEXY",
+	"0x994f0dffdbae0bbf09b652d6f11a493fd33f42b9": "EAGLE",
+	"0x9992ec3cf6a55b00978cddf2b27bc6882d88d1ec": "POLY",
+	"0x99ea4db9ee77acd40b119bd1dc4e33e1c070b80d": "QSP",
+	"0x9a005c9a89bd72a4bd27721e7a09a3c11d2b03c4": "STAC",
+	"0x9a642d6b3368ddc662ca244badf32cda716005bc": "QTUM",
+	"0x9b70740e708a083c6ff38df52297020f5dfaa5ee": "DAN",
+	"0x9e3319636e2126e3c0bc9e3134aec5e1508a46c7": "UTN-P",
+	"0x9e77d5a1251b6f7d456722a6eac6d2d5980bd891": "BRAT",
+	"0x9e88613418cf03dca54d6a2cf6ad934a78c7a17a": "SWM",
+	"0x9f8f72aa9304c8b593d555f12ef6589cc3a579a2": "MKR",
+	"0xa1ccc166faf0e998b3e33225a1a0301b1c86119d": "SGEL",
+	"0xa33e729bf4fdeb868b534e1f20523463d9c46bee": "ICO",
+	"0xa54ddc7b3cce7fc8b1e3fa0256d0db80d2c10970": "NDC",
+	"0xa578acc0cb7875781b7880903f4594d13cfa8b98": "ECN",
+	"0xa5fd1a791c4dfcaacc963d4f73c6ae5824149ea7": "JNT",
+	"0xa645264c5603e96c3b0b078cdab68733794b0a71": "MYST",
+	"0xa74476443119a942de498590fe1f2454d7d4ac0d": "GNT",
+	"0xa7f976c360ebbed4465c2855684d1aae5271efa9": "TFL",
+	"0xa8006c4ca56f24d6836727d106349320db7fef82": "INXT",
+	"0xa823e6722006afe99e91c30ff5295052fe6b8e32": "NEU",
+	"0xa89b5934863447f6e4fc53b315a93e873bda69a3": "LUM",
+	"0xa9240fbcac1f0b9a6adfb04a53c8e3b0cc1d1444": "HIG",
+	"0xa9877b1e05d035899131dbd1e403825166d09f92": "MNT",
+	"0xaaaf91d9b90df800df4f55c205fd6989c977e73a": "TKN",
+	"0xab16e0d25c06cb376259cc18c1de4aca57605589": "FUCK",
+	"0xab6cf87a50f17d7f5e1feaf81b6fe9ffbe8ebf84": "MRV",
+	"0xab95e915c123fded5bdfb6325e35ef5515f1ea69": "XNN",
+	"0xabdf147870235fcfc34153828c769a70b3fae01f": "EURT",
+	"0xac709fcb44a43c35f0da4e3163b117a17f3770f5": "ARC",
+	"0xacfa209fb73bf3dd5bbfb1101b9bc999c49062a5": "BCDT",
+	"0xae4f56f072c34c0a65b3ae3e4db797d831439d93": "GIM",
+	"0xae73b38d1c9a8b274127ec30160a4927c4d71824": "STK",
+	"0xaec2e87e0a235266d9c5adc9deb4b2e29b54d009": "SNGLS",
+	"0xaec98a708810414878c3bcdf46aad31ded4a4557": "300",
+	"0xaef38fbfbf932d1aef3b808bc8fbd8cd8e1f8bc5": "CRB",
+	"0xaf30d2a7e90d7dc361c8c4585e9bb7d2f6f15bc7": "1ST",
+	"0xaf4dce16da2877f8c9e00544c93b62ac40631f16": "MTH",
+	"0xafc39788c51f0c1ff7b55317f3e70299e521fff6": "eBCH",
+	"0xafe60511341a37488de25bef351952562e31fcc1": "TBT",
+	"0xb110ec7b1dcb8fab8dedbf28f53bc63ea5bedd84": "XID",
+	"0xb23be73573bc7e03db6e5dfc62405368716d28a8": "ONEK",
+	"0xb24754be79281553dc1adc160ddf5cd9b74361a4": "XRL",
+	"0xb2bfeb70b903f1baac7f2ba2c62934c7e5b974c4": "BKB",
+	"0xb2f7eb1f2c37645be61d73953035360e768d81e6": "COB",
+	"0xb3bd49e28f8f832b8d1e246106991e546c323502": "GMT",
+	"0xb45d7bc4cebcab98ad09babdf8c818b2292b672c": "HODL",
+	"0xb4b1d2c217ec0776584ce08d3dd98f90ededa44b": "CO2",
+	"0xb4efd85c19999d84251304bda99e90b92300bd93": "RPL",
+	"0xb53a96bcbdd9cf78dff20bab6c2be7baec8f00f8": "eGAS",
+	"0xb5a5f22694352c15b00323844ad545abb2b11028": "ICX",
+	"0xb63b606ac810a52cca15e44bb630fd42d8d1d83d": "MCO",
+	"0xb64ef51c888972c908cfacf59b47c1afbc0ab8ac": "STORJ",
+	"0xb67734521eabbe9c773729db73e16cc2dfb20a58": "E₹",
+	"0xb67b88a25708a35ae7c2d736d398d268ce4f7f83": "EMON",
+	"0xb6ee9668771a79be7967ee29a63d4184f8097143": "CXO",
+	"0xb70835d7822ebb9426b56543e391846c107bd32c": "GTC",
+	"0xb802b24e0637c2b87d2e8b7784c055bbe921011a": "EMV",
+	"0xb8c77482e45f1f44de1745f52c74426c631bdd52": "BNB",
+	"0xb91318f35bdb262e9423bc7c7c2a3a93dd93c92c": "NULS",
+	"0xb97048628db6b661d4c2aa833e95dbe1a905b280": "PAY",
+	"0xb98d4c97425d9908e66e53a6fdf673acca0be986": "ABT",
+	"0xb9e7f8568e08d5659f5d29c4997173d84cdf2607": "SWT",
+	"0xba2184520a1cc49a6159c57e61e1844e085615b6": "HGT",
+	"0xba5f11b16b155792cf3b2e6880e8706859a8aeb6": "ARN",
+	"0xbb9bc244d798123fde783fcc1c72d3bb8c189413": "DAO",
+	"0xbc1234552ebea32b5121190356bba6d3bb225bb5": "BCL",
+	"0xbdc5bac39dbe132b1e030e898ae3830017d7d969": "SNOV",
+	"0xbe11eeb186e624b8f26a5045575a1340e4054552": "CCC (ICONOMI)",
+	"0xbe99b09709fc753b09bcf557a992f6605d5997b0": "RLTY",
+	"0xbeb9ef514a379b997e0798fdcc901ee474b6d9a1": "MLN",
+	"0xbf2179859fc6d5bee9bf9158632dc51678a4100e": "ELF",
+	"0xbf4cfd7d1edeeea5f6600827411b41a21eb08abd": "CTL",
+	"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2": "WETH",
+	"0xc0eb85285d83217cd7c891702bcbc0fc401e2d9d": "HVN",
+	"0xc14830e53aa344e8c14603a91229a0b925b0b262": "PXT",
+	"0xc166038705ffbab3794185b3a9d925632a1df37d": "CC3",
+	"0xc27a2f05fa577a83ba0fdb4c38443c0718356501": "TAU",
+	"0xc2c63f23ec5e97efbd7565df9ec764fdc7d4e91d": "BOU",
+	"0xc34b21f6f8e51cc965c2393b3ccfa3b82beb2403": "IoT",
+	"0xc42209accc14029c1012fb5680d95fbd6036e2a0": "PPP",
+	"0xc66ea802717bfb9833400264dd12c2bceaa34a6d": "OLD_MKR",
+	"0xc798cd1c49db0e297312e4c682752668ce1db2ad": "LFR",
+	"0xc8c6a31a4a806d3710a7b38b7b296d2fabccdba8": "ELIX",
+	"0xc98e0639c6d2ec037a615341c369666b110e80e5": "EXMR",
+	"0xc9de4b7f0c3d991e967158e4d4bfa4b51ec0b114": "ROK",
+	"0xcb3f902bf97626391bf8ba87264bbc3dc13469be": "TRC",
+	"0xcb94be6f13a1182e4a4b6140cb7bf2025d28e41b": "TRST",
+	"0xcb97e65f07da24d46bcdd078ebebd7c6e6e3d750": "BTM",
+	"0xcbcc0f036ed4788f63fc0fee32873d6a7487b908": "HMQ",
+	"0xcc34366e3842ca1bd36c1f324d15257960fcc801": "BON",
+	"0xcc4ef9eeaf656ac1a2ab886743e98e97e090ed38": "DDF",
+	"0xcced5b8288086be8c38e23567e684c3740be4d48": "RLT",
+	"0xce59d29b09aae565feeef8e52f47c3cd5368c663": "BLX (Bullion)",
+	"0xced4e93198734ddaff8492d525bd258d49eb388e": "EDO",
+	"0xcfb98637bcae43c13323eaa1731ced2b716962fd": "NET",
+	"0xcfd6ae8bf13f42de14867351eaff7a8a3b9fbbe7": "SNG",
+	"0xd01db73e047855efb414e6202098c4be4cd2423b": "UQC",
+	"0xd0a4b8946cb52f0661273bfbc6fd0e0c75fc6433": "STORM",
+	"0xd0d6d6c5fe4a677d343cc433536bb717bae167dd": "ADT",
+	"0xd234bf2410a0009df9c3c63b610c09738f18ccd7": "DTR",
+	"0xd248b0d48e44aaf9c49aea0312be7e13a6dc1468": "SGT",
+	"0xd26114cd6ee289accf82350c8d8487fedb8a0c07": "OMG",
+	"0xd2d6158683aee4cc838067727209a0aaf4359de3": "BNTY",
+	"0xd341d1680eeee3255b8c4c75bcce7eb57f144dae": "onG",
+	"0xd348e07a2806505b856123045d27aeed90924b50": "CCLC",
+	"0xd3c00772b24d997a812249ca637a921e81357701": "WILD",
+	"0xd4c435f5b09f855c3317c8524cb1f586e42795fa": "CND",
+	"0xd4cffeef10f60eca581b5e1146b5aca4194a4c3b": "DUBI",
+	"0xd4fa1460f537bb9085d22c7bccb5dd450ef28e3a": "PPT",
+	"0xd6e354f07319e2474491d8c7c712137bee6862a2": "LEMO",
+	"0xd7631787b4dcc87b1254cfd1e5ce48e96823dee8": "SCL",
+	"0xd780ae2bf04cd96e577d3d014762f831d97129d0": "EVN",
+	"0xd850942ef8811f2a866692a623011bde52a462c1": "VET",
+	"0xd8912c10681d8b21fd3742244f44658dba12264e": "PLU",
+	"0xda6cb58a0d0c01610a29c5a65c303e13e885887c": "cV",
+	"0xdab0c31bf34c897fb0fe90d12ec9401caf5c36ec": "DAB",
+	"0xdac17f958d2ee523a2206206994597c13d831ec7": "USDT",
+	"0xdd007278b667f6bef52fd0a4c23604aa1f96039a": "RIPT",
+	"0xdd6bf56ca2ada24c683fac50e37783e55b57af9f": "BNC",
+	"0xdd94de9cfe063577051a5eb7465d08317d8808b6": "Devcon2 Token",
+	"0xdd974d5c2e2928dea5f71b9825b8b646686bd200": "KNC",
+	"0xdf6ef343350780bf8c3410bf062e0c015b1dd671": "BMC",
+	"0xe06eda7435ba749b047380ced49121dde93334ae": "SET",
+	"0xe0b7927c4af23765cb51314a0e0521a9645f0e2a": "DGD",
+	"0xe23cd160761f63fc3a1cf78aa034b6cdf97d3e0c": "MIT",
+	"0xe26517a9967299453d3f1b48aa005e6127e67210": "NIMFA",
+	"0xe2e6d4be086c6938b53b22144855eef674281639": "LINK Platform",
+	"0xe3818504c1b32bf1557b16c238b2e01fd3149c17": "PLR",
+	"0xe3831c5a982b279a198456d577cfb90424cb6340": "IMC",
+	"0xe386b139ed3715ca4b18fd52671bdcea1cdfe4b1": "ZST",
+	"0xe3fa177acecfb86721cf6f9f4206bd3bd672d7d5": "CTT",
+	"0xe41d2489571d322189246dafa5ebde1f4699f498": "ZRX",
+	"0xe43e2041dc3786e166961ed9484a5539033d10fb": "DNX",
+	"0xe477292f1b3268687a29376116b0ed27a9c76170": "PLAY",
+	"0xe4c94d45f7aef7018a5d66f44af780ec6023378e": "CryptoCarbon",
+	"0xe50365f5d679cb98a1dd62d6f6e58e59321bcddf": "LA",
+	"0xe5a7c12972f3bbfe70ed29521c8949b8af6a0970": "BLX (Iconomi)",
+	"0xe64509f0bf07ce2d29a7ef19a8a9bc065477c1b4": "PIPL",
+	"0xe6f74dcfa0e20883008d8c16b6d9a329189d0c30": "FTC",
+	"0xe7775a6e9bcf904eb39da2b68c5efb4f9360e08c": "TaaS",
+	"0xe8780b48bdb05f928697a5e8155f672ed91462f7": "CAS",
+	"0xe8a1df958be379045e2b46a31a98b93a2ecdfded": "ESZ",
+	"0xe8ff5c9c75deb346acac493c463c8950be03dfba": "VIBE",
+	"0xe933c0cd9784414d5f278c114904f5a84b396919": "WHO",
+	"0xe94327d07fc17907b4db788e5adf2ed424addff6": "REP",
+	"0xe9ff07809ccff05dae74990e25831d0bc5cbe575": "Hdp",
+	"0xea1f346faf023f974eb5adaf088bbcdf02d761f4": "TIX",
+	"0xea38eaa3c86c8f9b751533ba2e562deb9acded40": "FUEL",
+	"0xea5f88e54d982cbb0c441cde4e79bc305e5b43bc": "PARETO",
+	"0xea610b1153477720748dc13ed378003941d84fab": "ALIS",
+	"0xeab43193cf0623073ca89db9b712796356fa7414": "GOLDX",
+	"0xeb7c20027172e5d143fb030d50f91cece2d1485d": "eBTC",
+	"0xeb9951021698b42e4399f9cbb6267aa35f82d59d": "LIF",
+	"0xebed4ff9fe34413db8fc8294556bbd1528a4daca": "VENUS",
+	"0xec18f898b4076a3e18f1089d33376cc380bde61d": "PETRO",
+	"0xec46f8207d766012454c408de210bcbc2243e71c": "NOX",
+	"0xecd570bbf74761b960fa04cc10fe2c4e86ffda36": "STP",
+	"0xed247980396b10169bb1d36f6e278ed16700a60f": "AVA",
+	"0xedbaf3c5100302dcdda53269322f3730b1f0416d": "VRS",
+	"0xee609fe292128cad03b786dbb9bc2634ccdbe7fc": "POS",
+	"0xeef6e90034eea89e31eb4b8eacd323f28a92eae4": "DOW",
+	"0xef2e9966eb61bb494e5375d5df8d67b7db8a780d": "SHIT",
+	"0xef68e7c694f40c8202821edf525de3782458639f": "LRC",
+	"0xef6b4ce8c9bc83744fbcde2657b32ec18790458a": "PUC",
+	"0xf028adee51533b1b47beaa890feb54a457f51e89": "BMT",
+	"0xf04a8ac553fcedb5ba99a64799155826c136b0be": "FLIXX",
+	"0xf05a9382a4c3f29e2784502754293d88b835109c": "REX",
+	"0xf0da1186a4977226b9135d0613ee72e229ec3f4d": "CRT",
+	"0xf230b790e05390fc8295f4d3f60332c93bed42e2": "TRX",
+	"0xf26ef5e0545384b7dcc0f297f2674189586830df": "BSDC",
+	"0xf333b2ace992ac2bbd8798bf57bc65a06184afba": "SND",
+	"0xf3db5fa2c66b7af3eb0c0b782510816cbe4813b8": "EVX",
+	"0xf4134146af2d511dd5ea8cdb1c4ac88c57d60404": "SNC",
+	"0xf433089366899d83a9f26a773d59ec7ecf30355e": "MTL",
+	"0xf44745fbd41f6a1ba151df190db0564c5fcc4410": "CPY",
+	"0xf629cbd94d3791c9250152bd8dfbdf380e2a3b9c": "ENJ",
+	"0xf67451dc8421f0e0afeb52faa8101034ed081ed9": "GAM",
+	"0xf6b55acbbc49f4524aa48d19281a9a77c54de10f": "WLK",
+	"0xf6cfe53d6febaeea051f400ff5fc14f0cbbdaca1": "DGPT",
+	"0xf70a642bd387f94380ffb90451c2c81d4eb82cbc": "STAR",
+	"0xf7b098298f7c69fc14610bf71d5e02c60792894c": "GUP",
+	"0xf7e983781609012307f2514f63d526d83d24f466": "MYD",
+	"0xf85feea2fdd81d51177f6b8f35f0e6734ce45f5f": "CMT",
+	"0xf8e386eda857484f5a12e4b5daa9984e06e73705": "IND",
+	"0xf9f0fc7167c311dd2f1e21e9204f87eba9012fb2": "EHT",
+	"0xfa05a73ffe78ef8f1a739473e462c54bae6567d9": "LUN",
+	"0xfaccd5fc83c3e4c3c1ac1ef35d15adf06bcf209c": "TBC2",
+	"0xfad572db566e5234ac9fc3d570c4edc0050eaa92": "BTH",
+	"0xfb12e3cca983b9f59d90912fd17f8d745a8b2953": "LUCK",
+	"0xfb2f26f266fb2805a387230f2aa0a331b4d96fba": "DADI",
+	"0xfbd0d1c77b501796a35d86cf91d65d9778eee695": "TWNKL",
+	"0xfca47962d45adfdfd1ab2d972315db4ce7ccf094": "IXT",
+	"0xfcac7a7515e9a9d7619fa77a1fa738111f66727e": "PCH",
+	"0xfdbc1adc26f0f8f8606a5d63b7d3a3cd21c22b23": "1WO",
+	"0xfec0cf7fe078a500abf15f1284958f22049c2c7e": "ART",
+	"0xff18dbc487b4c2e3222d115952babfda8ba52f5f": "LIFE",
+	"0xffe8196bc259e8dedc544d935786aa4709ec3e64": "HDG",
+}
 
-		"0xff18dbc487b4c2e3222d115952babfda8ba52f5f": "LIFE",
-		"0x0f33bb20a282a7649c7b3aff644f084a9348e933": "YUPIE",
-		"0x8eb24319393716668d768dcec29356ae9cffe285": "AGI",
-		"0x2accab9cb7a48c3e82286f0b2f8798d201f4ec3f": "BTL (Battle)",
-		"0x13f11c9905a08ca76e3e853be63d4f0944326c72": "DIVX",
-		"0x86fa049857e0209aa7d9e616f7eb3b3b78ecfdb0": "EOS",
-		"0xf44745fbd41f6a1ba151df190db0564c5fcc4410": "CPY",
-		"0xf6b55acbbc49f4524aa48d19281a9a77c54de10f": "WLK",
-		"0xc27a2f05fa577a83ba0fdb4c38443c0718356501": "TAU",
-		"0x4672bad527107471cb5067a887f4656d585a8a31": "DROP (dropil)",
-		"0x8a187d5285d316bcbc9adafc08b51d70a0d8e000": "SIFT",
-		"0x0f5d2fb29fb7d3cfee444a200298f468908cc942": "MANA",
-		"0xf26ef5e0545384b7dcc0f297f2674189586830df": "BSDC",
-		"0x85089389c14bd9c77fc2b8f0c3d1dc3363bf06ef": "SPF",
-		"0xafc39788c51f0c1ff7b55317f3e70299e521fff6": "eBCH",
-		"0xf9f0fc7167c311dd2f1e21e9204f87eba9012fb2": "EHT",
-		"0xb23be73573bc7e03db6e5dfc62405368716d28a8": "ONEK",
-		"0x71e8d74ff1c923e369d0e70dfb09866629c4dd35": "WRK",
-		"0x43f6a1be992dee408721748490772b15143ce0a7": "POIN",
-		"0x92685e93956537c25bb75d5d47fca4266dd628b8": "BTL (Bitlle)",
-		"0x5f53f7a8075614b699baad0bc2c899f4bad8fbbf": "REBL",
-		"0xae4f56f072c34c0a65b3ae3e4db797d831439d93": "GIM",
-		"0x694404595e3075a942397f466aacd462ff1a7bd0": "PATENTS",
-		"0xcfd6ae8bf13f42de14867351eaff7a8a3b9fbbe7": "SNG",
-		"0xf333b2ace992ac2bbd8798bf57bc65a06184afba": "SND",
-		"0xf4134146af2d511dd5ea8cdb1c4ac88c57d60404": "SNC",
-		"0xa5fd1a791c4dfcaacc963d4f73c6ae5824149ea7": "JNT",
-		"0xaec2e87e0a235266d9c5adc9deb4b2e29b54d009": "SNGLS",
-		"0x983f6d60db79ea8ca4eb9968c6aff8cfa04b3c63": "SNM",
-		"0x744d70fdbe2ba4cf95131626614a1763df805b9e": "SNT",
-		"0x7641b2ca9ddd58addf6e3381c1f994aac5f1a32f": "PRPS",
-		"0xd3c00772b24d997a812249ca637a921e81357701": "WILD",
-		"0xd780ae2bf04cd96e577d3d014762f831d97129d0": "EVN",
-		"0x7e667525521cf61352e2e01b50faaae7df39749a": "CMC",
-		"0x923108a439c4e8c2315c4f6521e5ce95b44e9b4c": "EVE",
-		"0x4d8fc1453a0f359e99c9675954e656d80d996fbf": "BEE",
-		"0xf3db5fa2c66b7af3eb0c0b782510816cbe4813b8": "EVX",
-		"0xfcac7a7515e9a9d7619fa77a1fa738111f66727e": "PCH",
-		"0xf7b098298f7c69fc14610bf71d5e02c60792894c": "GUP",
-		"0x3618516f45cd3c913f81f9987af41077932bc40d": "PCL",
-		"0xd234bf2410a0009df9c3c63b610c09738f18ccd7": "DTR",
-		"0xf85feea2fdd81d51177f6b8f35f0e6734ce45f5f": "CMT",
-		"0x8aa33a7899fcc8ea5fbe6a608a109c3893a1b8b2": "BET",
-		"0x728781e75735dc0962df3a51d7ef47e798a7107e": "WOLK",
-		"0x5512e1d6a7be424b4323126b4f9e86d023f95764": "PTWO",
-		"0x08d32b0da63e2c3bcf8019c9c5d849d7a9d791e6": "DCN",
-		"0x2023dcf7c438c8c8c0b0f28dbae15520b4f3ee20": "FTR",
-		"0x107c4504cd79c5d2696ea0030a8dd4e92601b82e": "BLT",
-		"0x566fd7999b1fc3988022bd38507a48f0bcf22c77": "TRCN",
-		"0xe6f74dcfa0e20883008d8c16b6d9a329189d0c30": "FTC",
-		"0x1e09bd8cadb441632e441db3e1d79909ee0a2256": "DSC",
-		"0x5b2e4a700dfbc560061e957edec8f6eeeb74a320": "INS",
-		"0xec46f8207d766012454c408de210bcbc2243e71c": "NOX",
-		"0xf8e386eda857484f5a12e4b5daa9984e06e73705": "IND",
-		"0xe3fa177acecfb86721cf6f9f4206bd3bd672d7d5": "CTT",
-		"0xf05a9382a4c3f29e2784502754293d88b835109c": "REX",
-		"0x2108e62d335bbdc89ec3e9d8582f18dcfb0cdff4": "CARCO",
-		"0x5e3346444010135322268a4630d2ed5f8d09446c": "LOC",
-		"0xe94327d07fc17907b4db788e5adf2ed424addff6": "REP",
-		"0x8f8221afbb33998d8584a2b05749ba73c37a938a": "REQ",
-		"0x662abcad0b7f345ab7ffb1b1fbb9df7894f18e66": "CTX",
-		"0xab16e0d25c06cb376259cc18c1de4aca57605589": "FUCK",
-		"0x9992ec3cf6a55b00978cddf2b27bc6882d88d1ec": "POLY",
-		"0x4545750f39af6be4f237b6869d4ecca928fd5a85": "CTF",
-		"0x65292eeadf1426cd2df1c4793a3d7519f253913b": "COSS",
-		"0xbf4cfd7d1edeeea5f6600827411b41a21eb08abd": "CTL",
-		"0x767ba2915ec344015a7938e3eedfec2785195d05": "REA",
-		"0xeef6e90034eea89e31eb4b8eacd323f28a92eae4": "DOW",
-		"0x595832f8fc6bf59c85c527fec3740a1b7a361269": "POWR",
-		"0x9a642d6b3368ddc662ca244badf32cda716005bc": "QTUM",
-		"0x51db5ad35c671a87207d88fc11d593ac0c8415bd": "MDA",
-		"0x58bf7df57d9da7113c4ccb49d8463d4908c735cb": "SPARC",
-		"0xc798cd1c49db0e297312e4c682752668ce1db2ad": "LFR",
-		"0xd850942ef8811f2a866692a623011bde52a462c1": "VET",
-		"0xabdf147870235fcfc34153828c769a70b3fae01f": "EURT",
-		"0x8727c112c712c4a03371ac87a74dd6ab104af768": "JET",
-		"0x4d829f8c92a6691c56300d020c9e0db984cfe2ba": "XCC",
-		"0x93e682107d1e9defb0b5ee701c71707a4b2e46bc": "MCAP",
-		"0x9e3319636e2126e3c0bc9e3134aec5e1508a46c7": "UTN-P",
-		"0xaf30d2a7e90d7dc361c8c4585e9bb7d2f6f15bc7": "1ST",
-		"0x56ba2ee7890461f463f7be02aac3099f6d5811a8": "CAT (Blockcat)",
-		"0x08711d3b02c8758f2fb3ab4e80228418a7f8e39c": "EDG",
-		"0x84543f868ec1b1fac510d49d13c069f64cd2d5f9": "Hdp.ф",
-		"0xced4e93198734ddaff8492d525bd258d49eb388e": "EDO",
-		"0x607f4c5bb672230e8672085532f7e901544a7375": "RLC",
-		"0xef6b4ce8c9bc83744fbcde2657b32ec18790458a": "PUC",
-		"0x4a42d2c580f83dce404acad18dab26db11a1750e": "RLX",
-		"0x5b26c5d0772e5bbac8b3182ae9a13f9bb2d03765": "EDU",
-		"0xcced5b8288086be8c38e23567e684c3740be4d48": "RLT",
-		"0x3edd235c3e840c1f29286b2e39370a255c7b6fdb": "CMBT",
-		"0x0d8775f648430679a709e98d2b0cb6250d2887ef": "BAT",
-		"0xe2e6d4be086c6938b53b22144855eef674281639": "LINK Platform",
-		"0x01b3ec4aae1b8729529beb4965f27d008788b0eb": "DPP",
-		"0xe8780b48bdb05f928697a5e8155f672ed91462f7": "CAS",
-		"0x423e4322cdda29156b49a17dfbd2acc4b280600d": "CAR",
-		"0xb67b88a25708a35ae7c2d736d398d268ce4f7f83": "EMON",
-		"0x0996bfb5d057faa237640e2506be7b4f9c46de0b": "RNDR",
-		"0xec18f898b4076a3e18f1089d33376cc380bde61d": "PETRO",
-		"0x1d462414fe14cf489c7a21cac78509f4bf8cd7c0": "CAN",
-		"0xdd007278b667f6bef52fd0a4c23604aa1f96039a": "RIPT",
-		"0x24692791bc444c5cd0b81e3cbcaba4b04acd1f3b": "UKG",
-		"0x7d4b8cce0591c9044a22ee543533b72e976e36c3": "CAG",
-		"0x6425c6be902d692ae2db752b3c268afadb099d3b": "MWAT",
-		"0xe50365f5d679cb98a1dd62d6f6e58e59321bcddf": "LA",
-		"0x1b9743f556d65e757c4c650b4555baf354cb8bd3": "ETBS",
-		"0x6810e776880c02933d47db1b9fc05908e5386b96": "GNO",
-		"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2": "WETH",
-		"0x0affa06e7fbe5bc9a764c979aa66e8256a631f02": "PLBT",
-		"0x16662f73df3e79e54c6c5938b4313f92c524c120": "IIC",
-		"0xa9240fbcac1f0b9a6adfb04a53c8e3b0cc1d1444": "HIG",
-		"0x514910771af9ca656af840dff83e8264ecf986ca": "LINK (Chainlink)",
-		"0xeb7c20027172e5d143fb030d50f91cece2d1485d": "eBTC",
-		"0x1ec8fe51a9b6a3a6c427d17d9ecc3060fbc4a45c": "S-A-PAT",
-		"0x6a0a97e47d15aad1d132a1ac79a480e3f2079063": "WCT",
-		"0x629aee55ed49581c33ab27f9403f7992a289ffd5": "STC",
-		"0xba2184520a1cc49a6159c57e61e1844e085615b6": "HGT",
-		"0xb45d7bc4cebcab98ad09babdf8c818b2292b672c": "HODL",
-		"0xae73b38d1c9a8b274127ec30160a4927c4d71824": "STK",
-		"0x22f0af8d78851b72ee799e05f54a77001586b18a": "GXVC",
-		"0x006bea43baa3f7a6f765f14f10a1a1b08334ef45": "STX",
-		"0x6f6deb5db0c4994a8283a01d6cfeeb27fc3bbe9c": "SMART",
-		"0xa7f976c360ebbed4465c2855684d1aae5271efa9": "TFL",
-		"0x12fef5e57bf45873cd9b62e9dbd7bfb99e32d73e": "CFI",
-		"0x672a1ad4f667fb18a333af13667aa0af1f5b5bdd": "CRED",
-		"0xe26517a9967299453d3f1b48aa005e6127e67210": "NIMFA",
-		"0x5e4abe6419650ca839ce5bb7db422b881a6064bb": "WiC",
-		"0xeb9951021698b42e4399f9cbb6267aa35f82d59d": "LIF",
-		"0x697beac28b09e122c4332d163985e8a73121b97f": "QRL",
-		"0x4df47b4969b2911c966506e3592c41389493953b": "FND",
-		"0x882448f83d90b2bf477af2ea79327fdea1335d93": "VIBEX",
-		"0x28577a6d31559bd265ce3adb62d0458550f7b8a7": "CCC (CryptoCrashCourse)",
-		"0xe23cd160761f63fc3a1cf78aa034b6cdf97d3e0c": "MIT",
-		"0x572e6f318056ba0c5d47a422653113843d250691": "XNT",
-		"0x88a3e4f35d64aad41a6d4030ac9afe4356cb84fa": "PRE",
-		"0x7728dfef5abd468669eb7f9b48a7f70a501ed29d": "PRG",
-		"0xa8006c4ca56f24d6836727d106349320db7fef82": "INXT",
-		"0x78fe18e41f436e1981a3a60d1557c8a7a9370461": "SCANDI",
-		"0x1844b21593262668b7248d0f57a220caaba46ab9": "PRL",
-		"0x226bb599a12c826476e3a771454697ea52e9e220": "PRO",
-		"0xb67734521eabbe9c773729db73e16cc2dfb20a58": "E₹",
-		"0xbe11eeb186e624b8f26a5045575a1340e4054552": "CCC (ICONOMI)",
-		"0x163733bcc28dbf26b41a8cfa83e369b5b3af741b": "PRS",
-		"0x708876f486e448ee89eb332bfbc8e593553058b9": "GAVEL",
-		"0x4df812f6064def1e5e029f1ca858777cc98d2d81": "XAUR",
-		"0xfdbc1adc26f0f8f8606a5d63b7d3a3cd21c22b23": "1WO",
-		"0x1e49ff77c355a3e38d6651ce8404af0e48c5395f": "MTRc",
-		"0xab6cf87a50f17d7f5e1feaf81b6fe9ffbe8ebf84": "MRV",
-		"0x190e569be071f40c704e15825f285481cb74b6cc": "FAM",
-		"0x21f0f0fd3141ee9e11b3d7f13a1028cd515f459c": "MRP",
-		"0x53148bb4551707edf51a1e8d7a93698d18931225": "PCLOLD",
-		"0x05f4a42e251f2d52b8ed15e9fedaacfcef1fad27": "ZIL",
-		"0xe4c94d45f7aef7018a5d66f44af780ec6023378e": "CryptoCarbon",
-		"0x2c974b2d0ba1716e644c1fc59982a89ddd2ff724": "VIB",
-		"0x74c1e4b8cae59269ec1d85d3d4f324396048f4ac": "BeerCoin",
-		"0x12b306fa98f4cbb8d4457fdff3a0a0a56f07ccdf": "SXDT",
-		"0x23ae3c5b39b12f0693e05435eeaa1e51d8c61530": "APT",
-		"0x17f93475d2a978f527c3f7c44abf44adfba60d5c": "ECO2",
-		"0x27695e09149adc738a978e9a678f99e4c39e9eb9": "KICK",
-		"0x7654915a1b82d6d2d0afc37c52af556ea8983c7e": "IFT",
-		"0xf70a642bd387f94380ffb90451c2c81d4eb82cbc": "STAR",
-		"0x72dd4b6bd852a3aa172be4d6c5a6dbec588cf131": "NGC",
-		"0xffe8196bc259e8dedc544d935786aa4709ec3e64": "HDG",
-		"0x9a005c9a89bd72a4bd27721e7a09a3c11d2b03c4": "STAC",
-		"0x419d0d8bdd9af5e606ae2232ed285aff190e711b": "FUN",
-		"0xdf6ef343350780bf8c3410bf062e0c015b1dd671": "BMC",
-		"0xaaaf91d9b90df800df4f55c205fd6989c977e73a": "TKN",
-		"0x54b293226000ccbfc04df902eec567cb4c35a903": "RTN",
-		"0xcbcc0f036ed4788f63fc0fee32873d6a7487b908": "HMQ",
-		"0x3597bfd533a99c9aa083587b074434e61eb0a258": "DENT",
-		"0xe7775a6e9bcf904eb39da2b68c5efb4f9360e08c": "TaaS",
-		"0xf028adee51533b1b47beaa890feb54a457f51e89": "BMT",
-		"0x44197a4c44d6a059297caf6be4f7e172bd56caaf": "ELTCOIN",
-		"0x986ee2b944c42d017f52af21c4c69b84dbea35d8": "BMX",
-		"0x599346779e90fc3f5f997b5ea715349820f91571": "STN",
-		"0xf629cbd94d3791c9250152bd8dfbdf380e2a3b9c": "ENJ",
-		"0x07e3c70653548b04f0a75970c1f81b4cbbfb606f": "DLT",
-		"0xebed4ff9fe34413db8fc8294556bbd1528a4daca": "VENUS",
-		"0xe41d2489571d322189246dafa5ebde1f4699f498": "ZRX",
-		"0x080aa07e2c7185150d7e4da98838a8d2feac3dfc": "BTT",
-		"0x16b0e62ac13a2faed36d18bce2356d25ab3cfad3": "BTQ",
-		"0x3c75226555fc496168d48b88df83b95f16771f37": "DROP (droplex)",
-		"0x8c65e992297d5f092a756def24f4781a280198ff": "GZE",
-		"0x40395044ac3c0c57051906da938b54bd6557f212": "MGO",
-		"0xafe60511341a37488de25bef351952562e31fcc1": "TBT",
-		"0x73dd069c299a5d691e9836243bcaec9c8c1d8734": "BTE",
-		"0xd348e07a2806505b856123045d27aeed90924b50": "CCLC",
-		"0x8ae4bf2c33a8e667de34b54938b0ccd03eb8cc06": "PTOY",
-		"0x6531f133e6deebe7f2dce5a0441aa7ef330b4e53": "TIME",
-		"0xcb97e65f07da24d46bcdd078ebebd7c6e6e3d750": "BTM",
-		"0x12b19d3e2ccc14da04fae33e63652ce469b3f2fd": "GRID",
-		"0x59416a25628a76b4730ec51486114c32e0b582a1": "PLASMA",
-		"0xfad572db566e5234ac9fc3d570c4edc0050eaa92": "BTH",
-		"0x949bed886c739f1a3273629b3320db0c5024c719": "AMIS",
-		"0x056017c55ae7ae32d12aef7c679df83a85ca75ff": "WYV",
-		"0x422866a8f0b032c5cf1dfbdef31a20f4509562b0": "ADST",
-		"0x78b7fada55a64dd895d8c8c35779dd8b67fa8a05": "ATL",
-		"0x814cafd4782d2e728170fda68257983f03321c58": "IDEA",
-		"0x17052d51e954592c1046320c2371abab6c73ef10": "ATH",
-		"0x0aaf561eff5bd9c8f911616933f84166a17cfe0c": "JBX",
-		"0x887834d3b8d450b6bab109c252df3da286d73ce4": "ATT",
-		"0xa89b5934863447f6e4fc53b315a93e873bda69a3": "LUM",
-		"0xfa05a73ffe78ef8f1a739473e462c54bae6567d9": "LUN",
-		"0xe06eda7435ba749b047380ced49121dde93334ae": "SET",
-		"0xe0b7927c4af23765cb51314a0e0521a9645f0e2a": "DGD",
-		"0x27054b13b1b798b345b591a4d22e6562d47ea75a": "AST",
-		"0xa578acc0cb7875781b7880903f4594d13cfa8b98": "ECN",
-		"0xd341d1680eeee3255b8c4c75bcce7eb57f144dae": "onG",
-		"0x4cf488387f035ff08c371515562cba712f9015d4": "WPR",
-		"0x55b9a11c2e8351b4ffc7b11561148bfac9977855": "DGX",
-		"0x1a7a8bd9106f2b8d977e08582dc7d24c723ab0db": "APPC",
-		"0x63e634330a20150dbb61b15648bc73855d6ccf07": "LNC",
-		"0x62087245087125d3db5b9a3d713d78e7bbc31e54": "WPC",
-		"0xe3831c5a982b279a198456d577cfb90424cb6340": "IMC",
-		"0xb70835d7822ebb9426b56543e391846c107bd32c": "GTC",
-		"0xe9ff07809ccff05dae74990e25831d0bc5cbe575": "Hdp",
-		"0x22e5f62d0fa19974749faa194e3d3ef6d89c08d7": "IMT",
-		"0xce59d29b09aae565feeef8e52f47c3cd5368c663": "BLX (Bullion)",
-		"0xfec0cf7fe078a500abf15f1284958f22049c2c7e": "ART",
-		"0xeab43193cf0623073ca89db9b712796356fa7414": "GOLDX",
-		"0x7fce2856899a6806eeef70807985fc7554c66340": "CLP",
-		"0xef2e9966eb61bb494e5375d5df8d67b7db8a780d": "SHIT",
-		"0xd26114cd6ee289accf82350c8d8487fedb8a0c07": "OMG",
-		"0xc0eb85285d83217cd7c891702bcbc0fc401e2d9d": "HVN",
-		"0x72d32ac1c5e66bfc5b08806271f8eef915545164": "KEE",
-		"0x5e6b6d9abad9093fdc861ea1600eba1b355cd940": "ITC",
-		"0x4cc19356f2d37338b9802aa8e8fc58b0373296e7": "KEY",
-		"0x0aef06dcccc531e581f0440059e6ffcc206039ee": "ITT",
-		"0xe386b139ed3715ca4b18fd52671bdcea1cdfe4b1": "ZST",
-		"0x181a63746d3adcf356cbc73ace22832ffbb1ee5a": "ALCO",
-		"0x3f4b726668da46f5e0e75aa5d478acec9f38210f": "M-ETH",
-		"0x9e88613418cf03dca54d6a2cf6ad934a78c7a17a": "SWM",
-		"0x025abad9e518516fdaafbdcdb9701b37fb7ef0fa": "GTKT",
-		"0x0886949c1b8c412860c4264ceb8083d1365e86cf": "BTCE",
-		"0x4162178b78d6985480a308b2190ee5517460406d": "CLN",
-		"0x327682779bab2bf4d1337e8974ab9de8275a7ca8": "BPT",
-		"0x74951b677de32d596ee851a233336926e6a2cd09": "WBA",
-		"0x1234567461d3f8db7496581774bd869c83d51c93": "CAT (BitClave)",
-		"0xb9e7f8568e08d5659f5d29c4997173d84cdf2607": "SWT",
-		"0xcb94be6f13a1182e4a4b6140cb7bf2025d28e41b": "TRST",
-		"0x0abefb7611cb3a01ea3fad85f33c3c934f8e2cf4": "FRD",
-		"0x88fcfbc22c6d3dbaa25af478c578978339bde77a": "FYN",
-		"0xee609fe292128cad03b786dbb9bc2634ccdbe7fc": "POS",
-		"0x95daaab98046846bf4b2853e23cba236fa394a31": "EMONT",
-		"0x89205a3a3b2a69de6dbf7f01ed13b2108b2c43e7": "Unicorn",
-		"0x0e0989b1f9b8a38983c2ba8053269ca62ec9b195": "POE",
-		"0x6beb418fc6e1958204ac8baddcf109b8e9694966": "LNC-Linker Coin",
-		"0x7a5ff295dc8239d5c2374e4d894202aaf029cab6": "SLT",
-		"0xbeb9ef514a379b997e0798fdcc901ee474b6d9a1": "MLN",
-		"0x009e864923b49263c7f10d19b7f8ab7a9a5aad33": "FKX",
-		"0xb64ef51c888972c908cfacf59b47c1afbc0ab8ac": "STORJ",
-		"0xd0a4b8946cb52f0661273bfbc6fd0e0c75fc6433": "STORM",
-		"0x2bdc0d42996017fce214b21607a515da41a9e0c5": "SKIN",
-		"0x8f3470a7388c05ee4e7af3d01d8c722b0ff52374": "VERI",
-		"0x419c4db4b9e25d6db2ad9691ccb832c8d9fda05e": "DRGN",
-		"0x779b7b713c86e3e6774f5040d9ccc2d43ad375f8": "POOL",
-		"0xb63b606ac810a52cca15e44bb630fd42d8d1d83d": "MCO",
-		"0x533ef0984b2faa227acc620c67cce12aa39cd8cd": "XGM",
-		"0x2e071d2966aa7d8decb1005885ba1977d6038a65": "DICE",
-		"0x138a8752093f4f9a79aaedf48d4b9248fab93c9c": "MCI",
-		"0x1b5f21ee98eed48d292e8e2d3ed82b40a9728a22": "DATABroker",
-		"0x3eb91d237e491e0dee8582c402d85cb440fb6b54": "S-ETH",
-		"0x99ea4db9ee77acd40b119bd1dc4e33e1c070b80d": "QSP",
-		"0x2eb86e8fc520e0f6bb5d9af08f924fe70558ab89": "LGR",
-		"0x30f4a3e0ab7a76733d8b60b89dd93c3d0b4c9e2f": "XGT",
-		"0x4ca74185532dc1789527194e5b9c866dd33f4e82": "SenSatorI",
-		"0x671abbe5ce652491985342e85428eb1b07bc6c64": "QAU",
-		"0xb97048628db6b661d4c2aa833e95dbe1a905b280": "PAY",
-		"0xd01db73e047855efb414e6202098c4be4cd2423b": "UQC",
-		"0x44f588aeeb8c44471439d1270b3603c66a9262f1": "SNIP",
-		"0x7367a68039d4704f30bfbf6d948020c3b07dfc59": "BCBC",
-		"0x399a0e6fbeb3d74c85357439f4c8aed9678a5cbf": "DCL",
-		"0x386faa4703a34a7fdb19bec2e14fd427c9638416": "DCA",
-		"0x24a77c1f17c547105e14813e517be06b0040aa76": "LIVE",
-		"0x554c20b7c486beee439277b4540a434566dc4c02": "HST",
-		"0x8810c63470d38639954c6b41aac545848c46484a": "ADI",
-		"0x26e75307fc0c021472feb8f727839531f112f317": "C20",
-		"0xb110ec7b1dcb8fab8dedbf28f53bc63ea5bedd84": "XID",
-		"0xdd974d5c2e2928dea5f71b9825b8b646686bd200": "KNC",
-		"0x1f573d6fb3f13d689ff844b4ce37794d79a7ff1c": "BNT",
-		"0xdd6bf56ca2ada24c683fac50e37783e55b57af9f": "BNC",
-		"0xb8c77482e45f1f44de1745f52c74426c631bdd52": "BNB",
-		"0x9002d4485b7594e3e850f0a206713b305113f69e": "HAT",
-		"0x10b123fddde003243199aad03522065dc05827a0": "SYN",
-		"0x3136ef851592acf49ca4c825131e364170fa32b3": "COFI",
-		"0x2c82c73d5b34aa015989462b2948cd616a37641f": "SXUT",
-		"0x818fc6c2ec5986bc6e2cbf00939d90556ab12ce5": "KIN",
-		"0x6781a0f84c7e9e846dcb84a9a5bd49333067b104": "ZAP",
-		"0xf0da1186a4977226b9135d0613ee72e229ec3f4d": "CRT",
-		"0x4c382f8e09615ac86e08ce58266cc227e7d4d913": "SKR",
-		"0xaef38fbfbf932d1aef3b808bc8fbd8cd8e1f8bc5": "CRB",
-		"0x7585f835ae2d522722d2684323a0ba83401f32f5": "GBT",
-		"0xb802b24e0637c2b87d2e8b7784c055bbe921011a": "EMV",
-		"0x2ccbff3a042c68716ed2a2cb0c544a9f1d1935e1": "DMT",
-		"0xea5f88e54d982cbb0c441cde4e79bc305e5b43bc": "PARETO",
-		"0x4993cb95c7443bdc06155c5f5688be9d8f6999a5": "ROUND",
-		"0x994f0dffdbae0bbf09b652d6f11a493fd33f42b9": "EAGLE",
-		"0x66497a283e0a007ba3974e837784c6ae323447de": "PT",
-		"0xa1ccc166faf0e998b3e33225a1a0301b1c86119d": "SGEL",
-		"0x4f4f0db4de903b88f2b1a2847971e231d54f8fd3": "GEE",
-		"0x386467f1f3ddbe832448650418311a479eecfc57": "MBRS",
-		"0x83eea00d838f92dec4d1475697b9f4d3537b56e3": "VOISE",
-		"0x98f5e9b7f0e33956c0443e81bf7deb8b5b1ed545": "SEXY",
-		"0xcc4ef9eeaf656ac1a2ab886743e98e97e090ed38": "DDF",
-		"0x7c5a0ce9267ed19b22f8cae653f198e3e8daf098": "SAN",
-		"0x4355fc160f74328f9b383df2ec589bb3dfd82ba0": "OPT",
-		"0x7f585b9130c64e9e9f470b618a7badd03d79ca7e": "CR7",
-		"0x7f2176ceb16dcb648dc924eff617c3dc2befd30d": "OHNI",
-		"0x960b236a07cf122663c4303350609a66a7b288c0": "ANT",
-		"0xdd94de9cfe063577051a5eb7465d08317d8808b6": "Devcon2 Token",
-		"0x46492473755e8df960f8034877f61732d718ce96": "STRC",
-		"0x638ac149ea8ef9a1286c41b977017aa7359e6cfa": "ALTS",
-		"0xf04a8ac553fcedb5ba99a64799155826c136b0be": "FLIXX",
-		"0x957c30ab0426e0c93cd8241e2c60392d08c6ac8e": "MOD",
-		"0xbc1234552ebea32b5121190356bba6d3bb225bb5": "BCL",
-		"0x5884969ec0480556e11d119980136a4c17edded1": "PET",
-		"0xb2f7eb1f2c37645be61d73953035360e768d81e6": "COB",
-		"0x7dd7f56d697cc0f2b52bd55c057f378f1fe6ab4b": "$TEAK",
-		"0xedbaf3c5100302dcdda53269322f3730b1f0416d": "VRS",
-		"0x88ae96845e157558ef59e9ff90e766e22e480390": "IKB",
-		"0x954b5de09a55e59755acbda29e1eb74a45d30175": "FLUZ",
-		"0xb53a96bcbdd9cf78dff20bab6c2be7baec8f00f8": "eGAS",
-		"0xda6cb58a0d0c01610a29c5a65c303e13e885887c": "cV",
-		"0x5c6183d10a00cd747a6dbb5f658ad514383e9419": "NXX OLD",
-		"0x06012c8cf97bead5deae237070f9587f8e7a266d": "CK",
-		"0xa54ddc7b3cce7fc8b1e3fa0256d0db80d2c10970": "NDC",
-		"0x68d57c9a1c35f63e2c83ee8e49a64e9d70528d25": "SRN",
-		"0x1c4481750daa5ff521a2a7490d9981ed46465dbd": "BCPT",
-		"0x39bb259f66e1c59d5abef88375979b4d20d98022": "WAX",
-		"0xd8912c10681d8b21fd3742244f44658dba12264e": "PLU",
-		"0xb4b1d2c217ec0776584ce08d3dd98f90ededa44b": "CO2",
-		"0x1776e1f26f98b1a5df9cd347953a26dd3cb46671": "NMR",
-		"0xe3818504c1b32bf1557b16c238b2e01fd3149c17": "PLR",
-		"0x8a95ca448a52c0adf0054bb3402dc5e09cd6b232": "CDL",
-		"0xf7e983781609012307f2514f63d526d83d24f466": "MYD",
-		"0x177d39ac676ed1c67a2b268ad7f1e58826e5b0af": "CDT",
-		"0x24083bb30072643c3bb90b44b7285860a755e687": "GELD",
-		"0xea610b1153477720748dc13ed378003941d84fab": "ALIS",
-		"0x6fff3806bbac52a20e0d79bc538d527f6a22c96b": "CDX",
-		"0xfb12e3cca983b9f59d90912fd17f8d745a8b2953": "LUCK",
-		"0x1245ef80f4d9e02ed9425375e8f649b9221b31d8": "ARCT",
-		"0xd6e354f07319e2474491d8c7c712137bee6862a2": "LEMO",
-		"0x1063ce524265d5a3a624f4914acd573dd89ce988": "AIX",
-		"0xa74476443119a942de498590fe1f2454d7d4ac0d": "GNT",
-		"0x2a3aa9eca41e720ed46b5a70d6c37efa47f768ac": "RCT",
-		"0x21ae23b882a340a22282162086bc98d3e2b73018": "LOK",
-		"0x0c04d4f331da8df75f9e2e271e3f3f1494c66c36": "PRSP",
-		"0x27dce1ec4d3f72c3e457cc50354f1f975ddef488": "AIR",
-		"0x9f8f72aa9304c8b593d555f12ef6589cc3a579a2": "MKR",
-		"0x83cee9e086a77e492ee0bb93c2b0437ad6fdeccc": "MNTP",
-		"0xc8c6a31a4a806d3710a7b38b7b296d2fabccdba8": "ELIX",
-		"0x41e5560054824ea6b0732e656e3ad64e20e94e45": "CVC",
-		"0xc66ea802717bfb9833400264dd12c2bceaa34a6d": "OLD_MKR",
-		"0xe5a7c12972f3bbfe70ed29521c8949b8af6a0970": "BLX (Iconomi)",
-		"0xe8ff5c9c75deb346acac493c463c8950be03dfba": "VIBE",
-		"0xb98d4c97425d9908e66e53a6fdf673acca0be986": "ABT",
-		"0x1f54638b7737193ffd86c19ec51907a7c41755d8": "SOL",
-		"0xf67451dc8421f0e0afeb52faa8101034ed081ed9": "GAM",
-		"0xc42209accc14029c1012fb5680d95fbd6036e2a0": "PPP",
-		"0xb24754be79281553dc1adc160ddf5cd9b74361a4": "XRL",
-		"0xd4fa1460f537bb9085d22c7bccb5dd450ef28e3a": "PPT",
-		"0xfbd0d1c77b501796a35d86cf91d65d9778eee695": "TWNKL",
-		"0x0af44e2784637218dd1d32a322d44e603a8f0c6a": "MTX",
-		"0x7fc408011165760ee31be2bf20daf450356692af": "MTR",
-		"0x70a72833d6bf7f508c8224ce59ea1ef3d0ea3a38": "UTK",
-		"0x910dfc18d6ea3d6a7124a6f8b5458f281060fa4c": "X8X",
-		"0xaf4dce16da2877f8c9e00544c93b62ac40631f16": "MTH",
-		"0x41dbecc1cdc5517c6f76f6a6e836adbee2754de3": "MTN",
-		"0xf433089366899d83a9f26a773d59ec7ecf30355e": "MTL",
-		"0xfb2f26f266fb2805a387230f2aa0a331b4d96fba": "DADI",
-		"0x7705faa34b16eb6d77dfc7812be2367ba6b0248e": "ARX",
-		"0xac709fcb44a43c35f0da4e3163b117a17f3770f5": "ARC",
-		"0x75aa7b0d02532f3833b66c7f0ad35376d373ddf8": "ARD",
-		"0x45e42d659d9f9466cd5df622506033145a9b89bc": "NxC",
-		"0x42d6622dece394b54999fbd73d108123806f6a18": "SPANK",
-		"0xba5f11b16b155792cf3b2e6880e8706859a8aeb6": "ARN",
-		"0x1e797ce986c3cff4472f7d38d5c4aba55dfefe40": "BCDN",
-		"0xea1f346faf023f974eb5adaf088bbcdf02d761f4": "TIX",
-		"0x7f1e2c7d6a69bf34824d72c53b4550e895c0d8c2": "BOP",
-		"0x621d78f2ef2fd937bfca696cabaf9a779f59b3ed": "DRP",
-		"0xc2c63f23ec5e97efbd7565df9ec764fdc7d4e91d": "BOU",
-		"0x315ce59fafd3a8d562b7ec1c8542382d2710b06c": "CCS",
-		"0xd248b0d48e44aaf9c49aea0312be7e13a6dc1468": "SGT",
-		"0x80bc5512561c7f85a3a9508c7df7901b370fa1df": "TIO",
-		"0xcc34366e3842ca1bd36c1f324d15257960fcc801": "BON",
-		"0x701c244b988a513c945973defa05de933b23fe1d": "OAX",
-		"0x41f615e24fabd2b097a320e9e6c1f448cb40521c": "RVL",
-		"0x8effd494eb698cc399af6231fccd39e08fd20b15": "PIX",
-		"0x2c3c1f05187dba7a5f2dd47dca57281c4d4f183f": "QTQ",
-		"0x3d1ba9be9f66b8ee101911bc36d3fb562eac2244": "RVT",
-		"0x14f37b574242d366558db61f3335289a5035c506": "HKG",
-		"0x4994e81897a920c0fea235eb8cedeed3c6fff697": "SKO1",
-		"0x2dcfaac11c9eebd8c6c42103fe9e2a6ad237af27": "SMT",
-		"0x7627de4b93263a6a7570b8dafa64bae812e5c394": "NXX",
-		"0xc98e0639c6d2ec037a615341c369666b110e80e5": "EXMR",
-		"0xd4cffeef10f60eca581b5e1146b5aca4194a4c3b": "DUBI",
-		"0x773450335ed4ec3db45af74f34f2c85348645d39": "JetCoins",
-		"0x5af2be193a6abca9c8817001f45744777db30756": "BQX",
-		"0x58ca3065c0f24c7c96aee8d6056b5b5decf9c2f8": "GXC",
-		"0xc166038705ffbab3794185b3a9d925632a1df37d": "CC3",
-		"0x0cf0ee63788a0849fe5297f3407f701e122cc023": "DATACoin",
-		"0x2134057c0b461f898d375cead652acae62b59541": "CXC",
-		"0x408e41876cccdc0f92210600ef50372656052a38": "REN",
-		"0xb6ee9668771a79be7967ee29a63d4184f8097143": "CXO",
-		"0x70b147e01e9285e7ce68b9ba437fe3a9190e756a": "FLX",
-		"0x55c2a0c171d920843560594de3d6eecc09efc098": "PEXT",
-		"0x3a1bda28adb5b0a812a7cf10a1950c920f79bcd3": "FLP",
-		"0xc34b21f6f8e51cc965c2393b3ccfa3b82beb2403": "IoT",
-		"0x0f513ffb4926ff82d7f60a05069047aca295c413": "XSC",
-		"0x64cdf819d3e75ac8ec217b3496d7ce167be42e80": "IPL",
-		"0x5ca9a71b1d01849c0a95490cc00559717fcf0d1d": "AE",
-		"0x151202c9c18e495656f372281f493eb7698961d5": "DEB",
-		"0xd7631787b4dcc87b1254cfd1e5ce48e96823dee8": "SCL",
-		"0x4e0603e2a27a30480e5e3a4fe548e29ef12f64be": "CREDO",
-		"0xc9de4b7f0c3d991e967158e4d4bfa4b51ec0b114": "ROK",
-		"0x4dc3643dbc642b72c158e7f3d2ff232df61cb6ce": "AMB",
-		"0xa9877b1e05d035899131dbd1e403825166d09f92": "MNT",
-		"0x2c4e8f2d746113d0696ce89b35f0d8bf88e0aeca": "OST",
-		"0x68e14bb5a45b9681327e16e528084b9d962c1a39": "CATs (BitClave)_Old",
-		"0xbe99b09709fc753b09bcf557a992f6605d5997b0": "RLTY",
-		"0xfaccd5fc83c3e4c3c1ac1ef35d15adf06bcf209c": "TBC2",
-		"0x4ceda7906a5ed2179785cd3a40a69ee8bc99c466": "AION",
-		"0x1a95b271b0535d15fa49932daba31ba612b52946": "MNE",
-		"0x1961b3331969ed52770751fc718ef530838b6dee": "BDG",
-		"0xd0d6d6c5fe4a677d343cc433536bb717bae167dd": "ADT",
-		"0xaec98a708810414878c3bcdf46aad31ded4a4557": "300",
-		"0xb4efd85c19999d84251304bda99e90b92300bd93": "RPL",
-		"0xcb3f902bf97626391bf8ba87264bbc3dc13469be": "TRC",
-		"0x519475b31653e46d20cd09f9fdcf3b12bdacb4f5": "VIU",
-		"0xe8a1df958be379045e2b46a31a98b93a2ecdfded": "ESZ",
-		"0xdac17f958d2ee523a2206206994597c13d831ec7": "USDT",
-		"0xf230b790e05390fc8295f4d3f60332c93bed42e2": "TRX",
-		"0x6e34d8d84764d40f6d7b39cd569fd017bf53177d": "SKRP",
-		"0x55648de19836338549130b1af587f16bea46f66b": "PBL",
-		"0xef68e7c694f40c8202821edf525de3782458639f": "LRC",
-		"0x0d88ed6e74bbfd96b831231638b66c05571e824f": "AVT",
-		"0xa645264c5603e96c3b0b078cdab68733794b0a71": "MYST",
-		"0x509a38b7a1cc0dcd83aa9d06214663d9ec7c7f4a": "BST",
-		"0x6745fab6801e376cd24f03572b9c9b0d4edddccf": "SENSE",
-		"0xed247980396b10169bb1d36f6e278ed16700a60f": "AVA",
-		"0x13f1b7fdfbe1fc66676d56483e21b1ecb40b58e2": "ACC",
-		"0xe933c0cd9784414d5f278c114904f5a84b396919": "WHO",
-		"0x12480e24eb5bec1a9d4369cab6a80cad3c0a377a": "SUB",
-		"0xfca47962d45adfdfd1ab2d972315db4ce7ccf094": "IXT",
-		"0xcfb98637bcae43c13323eaa1731ced2b716962fd": "NET",
-		"0xa823e6722006afe99e91c30ff5295052fe6b8e32": "NEU",
-		"0xab95e915c123fded5bdfb6325e35ef5515f1ea69": "XNN",
-		"0x85e076361cc813a908ff672f9bad1541474402b2": "TEL",
-		"0xb2bfeb70b903f1baac7f2ba2c62934c7e5b974c4": "BKB",
-		"0xecd570bbf74761b960fa04cc10fe2c4e86ffda36": "STP",
-		"0x65a15014964f2102ff58647e16a16a6b9e14bcf6": "Ox Fina",
-		"0xe64509f0bf07ce2d29a7ef19a8a9bc065477c1b4": "PIPL",
-		"0x4156d3342d5c385a87d264f90653733592000581": "SALT",
-		"0x014b50466590340d41307cc54dcee990c8d58aa8": "ICOS",
-		"0xbf2179859fc6d5bee9bf9158632dc51678a4100e": "ELF",
-		"0x4470bb87d77b963a013db939be332f927f2b992e": "ADX",
-		"0x0abdace70d3790235af448c88547603b945604ea": "DNT",
-		"0x255aa6df07540cb5d3d297f0d0d4d84cb52bc8e6": "RDN",
-		"0xe43e2041dc3786e166961ed9484a5539033d10fb": "DNX",
-		"0xb3bd49e28f8f832b8d1e246106991e546c323502": "GMT",
-		"0x62cd07d414ec50b68c7ecaa863a23d344f2d062f": "WIC",
-		"0x286bda1413a2df81731d4930ce2f862a35a609fe": "WaBi",
-		"0x9541fd8b9b5fa97381783783cebf2f5fa793c262": "KZN",
-		"0x667088b212ce3d06a1b553a7221e1fd19000d9af": "WINGS",
-		"0xf6cfe53d6febaeea051f400ff5fc14f0cbbdaca1": "DGPT",
-		"0xb91318f35bdb262e9423bc7c7c2a3a93dd93c92c": "NULS",
-		"0x07d9e49ea402194bf48a8276dafb16e4ed633317": "DALC",
-		"0x814964b1bceaf24e26296d031eadf134a2ca4105": "NEWB",
-		"0x2ef1ab8a26187c58bb8aaeb11b2fc6d25c5c0716": "TWN",
-		"0x68aa3f232da9bdc2343465545794ef3eea5209bd": "MSP",
-		"0x80a7e048f37a50500351c204cb407766fa3bae7f": "CRPT",
-		"0xdab0c31bf34c897fb0fe90d12ec9401caf5c36ec": "DAB",
-		"0x9b70740e708a083c6ff38df52297020f5dfaa5ee": "DAN",
-		"0xbb9bc244d798123fde783fcc1c72d3bb8c189413": "DAO",
-		"0x89d24a6b4ccb1b6faa2625fe562bdd9a23260359": "DAI",
-		"0xc14830e53aa344e8c14603a91229a0b925b0b262": "PXT",
-		"0x81c9151de0c8bafcd325a57e3db5a5df1cebf79c": "DAT",
-		"0xacfa209fb73bf3dd5bbfb1101b9bc999c49062a5": "BCDT",
-		"0xd2d6158683aee4cc838067727209a0aaf4359de3": "BNTY",
-		"0x08f5a9235b08173b7569f83645d2c7fb55e8ccd8": "TNT",
-		"0xe477292f1b3268687a29376116b0ed27a9c76170": "PLAY",
-		"0xa33e729bf4fdeb868b534e1f20523463d9c46bee": "ICO",
-		"0x888666ca69e0f178ded6d75b5726cee99a87d698": "ICN",
-		"0x3a26746ddb79b1b8e4450e3f4ffe3285a307387e": "ETHB",
-		"0x5a84969bb663fb64f6d015dcf9f622aedc796750": "ICE",
-		"0xea38eaa3c86c8f9b751533ba2e562deb9acded40": "FUEL",
-		"0xd4c435f5b09f855c3317c8524cb1f586e42795fa": "CND",
-		"0xb5a5f22694352c15b00323844ad545abb2b11028": "ICX",
-		"0x103c3a209da59d3e7c4a89307e66521e081cfdf0": "GVT",
-		"0x9e77d5a1251b6f7d456722a6eac6d2d5980bd891": "BRAT",
-		"0xbdc5bac39dbe132b1e030e898ae3830017d7d969": "SNOV",
-		"0x24aef3bf1a47561500f9430d74ed4097c47f51f2": "SPARTA",
-		"0x340d2bde5eb28c1eed91b2f790723e3b160613b7": "VEE",
-		"0x5c543e7ae0a1104f78406c340e9c64fd9fce5170": "VSL",
-	}
-	T2A = map[string]string{
+// T2A maps token symbols to their addresses.
+var T2A = map[string]string{
+	"$TEAK":                   "0x7dd7f56d697cc0f2b52bd55c057f378f1fe6ab4b",
+	"1ST":                     "0xaf30d2a7e90d7dc361c8c4585e9bb7d2f6f15bc7",
+	"1WO":                     "0xfdbc1adc26f0f8f8606a5d63b7d3a3cd21c22b23",
+	"300":                     "0xaec98a708810414878c3bcdf46aad31ded4a4557",
+	"ABT":                     "0xb98d4c97425d9908e66e53a6fdf673acca0be986",
+	"ACC":                     "0x13f1b7fdfbe1fc66676d56483e21b1ecb40b58e2",
+	"ADI":                     "0x8810c63470d38639954c6b41aac545848c46484a",
+	"ADST":                    "0x422866a8f0b032c5cf1dfbdef31a20f4509562b0",
+	"ADT":                     "0xd0d6d6c5fe4a677d343cc433536bb717bae167dd",
+	"ADX":                     "0x4470bb87d77b963a013db939be332f927f2b992e",
+	"AE":                      "0x5ca9a71b1d01849c0a95490cc00559717fcf0d1d",
+	"AGI":                     "0x8eb24319393716668d768dcec29356ae9cffe285",
+	"AION":                    "0x4ceda7906a5ed2179785cd3a40a69ee8bc99c466",
+	"AIR":                     "0x27dce1ec4d3f72c3e457cc50354f1f975ddef488",
+	"AIX":                     "0x1063ce524265d5a3a624f4914acd573dd89ce988",
+	"ALCO":                    "0x181a63746d3adcf356cbc73ace22832ffbb1ee5a",
+	"ALIS":                    "0xea610b1153477720748dc13ed378003941d84fab",
+	"ALTS":                    "0x638ac149ea8ef9a1286c41b977017aa7359e6cfa",
+	"AMB":                     "0x4dc3643dbc642b72c158e7f3d2ff232df61cb6ce",
+	"AMIS":                    "0x949bed886c739f1a3273629b3320db0c5024c719",
+	"ANT":                     "0x960b236a07cf122663c4303350609a66a7b288c0",
+	"APPC":                    "0x1a7a8bd9106f2b8d977e08582dc7d24c723ab0db",
+	"APT":                     "0x23ae3c5b39b12f0693e05435eeaa1e51d8c61530",
+	"ARC":                     "0xac709fcb44a43c35f0da4e3163b117a17f3770f5",
+	"ARCT":                    "0x1245ef80f4d9e02ed9425375e8f649b9221b31d8",
+	"ARD":                     "0x75aa7b0d02532f3833b66c7f0ad35376d373ddf8",
+	"ARN":                     "0xba5f11b16b155792cf3b2e6880e8706859a8aeb6",
+	"ART":                     "0xfec0cf7fe078a500abf15f1284958f22049c2c7e",
+	"ARX":                     "0x7705faa34b16eb6d77dfc7812be2367ba6b0248e",
+	"AST":                     "0x27054b13b1b798b345b591a4d22e6562d47ea75a",
+	"ATH":                     "0x17052d51e954592c1046320c2371abab6c73ef10",
+	"ATL":                     "0x78b7fada55a64dd895d8c8c35779dd8b67fa8a05",
+	"ATT":                     "0x887834d3b8d450b6bab109c252df3da286d73ce4",
+	"AVA":                     "0xed247980396b10169bb1d36f6e278ed16700a60f",
+	"AVT":                     "0x0d88ed6e74bbfd96b831231638b66c05571e824f",
+	"BAT":                     "0x0d8775f648430679a709e98d2b0cb6250d2887ef",
+	"BCBC":                    "0x7367a68039d4704f30bfbf6d948020c3b07dfc59",
+	"BCDN":                    "0x1e797ce986c3cff4472f7d38d5c4aba55dfefe40",
+	"BCDT":                    "0xacfa209fb73bf3dd5bbfb1101b9bc999c49062a5",
+	"BCL":                     "0xbc1234552ebea32b5121190356bba6d3bb225bb5",
+	"BCPT":                    "0x1c4481750daa5ff521a2a7490d9981ed46465dbd",
+	"BDG":                     "0x1961b3331969ed52770751fc718ef530838b6dee",
+	"BEE":                     "0x4d8fc1453a0f359e99c9675954e656d80d996fbf",
+	"BET":                     "0x8aa33a7899fcc8ea5fbe6a608a109c3893a1b8b2",
+	"BKB":                     "0xb2bfeb70b903f1baac7f2ba2c62934c7e5b974c4",
+	"BLT":                     "0x107c4504cd79c5d2696ea0030a8dd4e92601b82e",
+	"BLX (Bullion)":           "0xce59d29b09aae565feeef8e52f47c3cd5368c663",
+	"BLX (Iconomi)":           "0xe5a7c12972f3bbfe70ed29521c8949b8af6a0970",
+	"BMC":                     "0xdf6ef343350780bf8c3410bf062e0c015b1dd671",
+	"BMT":                     "0xf028adee51533b1b47beaa890feb54a457f51e89",
+	"BMX":                     "0x986ee2b944c42d017f52af21c4c69b84dbea35d8",
+	"BNB":                     "0xb8c77482e45f1f44de1745f52c74426c631bdd52",
+	"BNC":                     "0xdd6bf56ca2ada24c683fac50e37783e55b57af9f",
+	"BNT":                     "0x1f573d6fb3f13d689ff844b4ce37794d79a7ff1c",
+	"BNTY":                    "0xd2d6158683aee4cc838067727209a0aaf4359de3",
+	"BON":                     "0xcc34366e3842ca1bd36c1f324d15257960fcc801",
+	"BOP":                     "0x7f1e2c7d6a69bf34824d72c53b4550e895c0d8c2",
+	"BOU":                     "0xc2c63f23ec5e97efbd7565df9ec764fdc7d4e91d",
+	"BPT":                     "0x327682779bab2bf4d1337e8974ab9de8275a7ca8",
+	"BQX":                     "0x5af2be193a6abca9c8817001f45744777db30756",
+	"BRAT":                    "0x9e77d5a1251b6f7d456722a6eac6d2d5980bd891",
+	"BSDC":                    "0xf26ef5e0545384b7dcc0f297f2674189586830df",
+	"BST":                     "0x509a38b7a1cc0dcd83aa9d06214663d9ec7c7f4a",
+	"BTCE":                    "0x0886949c1b8c412860c4264ceb8083d1365e86cf",
+	"BTE":                     "0x73dd069c299a5d691e9836243bcaec9c8c1d8734",
+	"BTH":                     "0xfad572db566e5234ac9fc3d570c4edc0050eaa92",
+	"BTL (Battle)":            "0x2accab9cb7a48c3e82286f0b2f8798d201f4ec3f",
+	"BTL (Bitlle)":            "0x92685e93956537c25bb75d5d47fca4266dd628b8",
+	"BTM":                     "0xcb97e65f07da24d46bcdd078ebebd7c6e6e3d750",
+	"BTQ":                     "0x16b0e62ac13a2faed36d18bce2356d25ab3cfad3",
+	"BTT":                     "0x080aa07e2c7185150d7e4da98838a8d2feac3dfc",
+	"BeerCoin":                "0x74c1e4b8cae59269ec1d85d3d4f324396048f4ac",
+	"C20":                     "0x26e75307fc0c021472feb8f727839531f112f317",
+	"CAG":                     "0x7d4b8cce0591c9044a22ee543533b72e976e36c3",
+	"CAN":                     "0x1d462414fe14cf489c7a21cac78509f4bf8cd7c0",
+	"CAR":                     "0x423e4322cdda29156b49a17dfbd2acc4b280600d",
+	"CARCO":                   "0x2108e62d335bbdc89ec3e9d8582f18dcfb0cdff4",
+	"CAS":                     "0xe8780b48bdb05f928697a5e8155f672ed91462f7",
+	"CAT (BitClave)":          "0x1234567461d3f8db7496581774bd869c83d51c93",
+	"CAT (Blockcat)":          "0x56ba2ee7890461f463f7be02aac3099f6d5811a8",
+	"CATs (BitClave)_Old":     "0x68e14bb5a45b9681327e16e528084b9d962c1a39",
+	"CC3":                     "0xc166038705ffbab3794185b3a9d925632a1df37d",
+	"CCC (CryptoCrashCourse)": "0x28577a6d31559bd265ce3adb62d0458550f7b8a7",
+	"CCC (ICONOMI)":           "0xbe11eeb186e624b8f26a5045575a1340e4054552",
+	"CCLC":                    "0xd348e07a2806505b856123045d27aeed90924b50",
+	"CCS":                     "0x315ce59fafd3a8d562b7ec1c8542382d2710b06c",
+	"CDL":                     "0x8a95ca448a52c0adf0054bb3402dc5e09cd6b232",
+	"CDT":                     "0x177d39ac676ed1c67a2b268ad7f1e58826e5b0af",
+	"CDX":                     "0x6fff3806bbac52a20e0d79bc538d527f6a22c96b",
+	"CFI":                     "0x12fef5e57bf45873cd9b62e9dbd7bfb99e32d73e",
+	"CK":                      "0x06012c8cf97bead5deae237070f9587f8e7a266d",
+	"CLN":                     "0x4162178b78d6985480a308b2190ee5517460406d",
+	"CLP":                     "0x7fce2856899a6806eeef70807985fc7554c66340",
+	"CMBT":                    "0x3edd235c3e840c1f29286b2e39370a255c7b6fdb",
+	"CMC":                     "0x7e667525521cf61352e2e01b50faaae7df39749a",
+	"CMT":                     "0xf85feea2fdd81d51177f6b8f35f0e6734ce45f5f",
+	"CND":                     "0xd4c435f5b09f855c3317c8524cb1f586e42795fa",
+	"CO2":                     "0xb4b1d2c217ec0776584ce08d3dd98f90ededa44b",
+	"COB":                     "0xb2f7eb1f2c37645be61d73953035360e768d81e6",
+	"COFI":                    "0x3136ef851592acf49ca4c825131e364170fa32b3",
+	"COSS":                    "0x65292eeadf1426cd2df1c4793a3d7519f253913b",
+	"CPY":                     "0xf44745fbd41f6a1ba151df190db0564c5fcc4410",
+	"CR7":                     "0x7f585b9130c64e9e9f470b618a7badd03d79ca7e",
+	"CRB":                     "0xaef38fbfbf932d1aef3b808bc8fbd8cd8e1f8bc5",
+	"CRED":                    "0x672a1ad4f667fb18a333af13667aa0af1f5b5bdd",
+	"CREDO":                   "0x4e0603e2a27a30480e5e3a4fe548e29ef12f64be",
+	"CRPT":                    "0x80a7e048f37a50500351c204cb407766fa3bae7f",
+	"CRT":                     "0xf0da1186a4977226b9135d0613ee72e229ec3f4d",
+	"CTF":                     "0x4545750f39af6be4f237b6869d4ecca928fd5a85",
+	"CTL":                     "0xbf4cfd7d1edeeea5f6600827411b41a21eb08abd",
+	"CTT":                     "0xe3fa177acecfb86721cf6f9f4206bd3bd672d7d5",
+	"CTX":                     "0x662abcad0b7f345ab7ffb1b1fbb9df7894f18e66",
+	"CVC":                     "0x41e5560054824ea6b0732e656e3ad64e20e94e45",
+	"CXC":                     "0x2134057c0b461f898d375cead652acae62b59541",
+	"CXO":                     "0xb6ee9668771a79be7967ee29a63d4184f8097143",
+	"CryptoCarbon":            "0xe4c94d45f7aef7018a5d66f44af780ec6023378e",
+	"DAB":                     "0xdab0c31bf34c897fb0fe90d12ec9401caf5c36ec",
+	"DADI":                    "0xfb2f26f266fb2805a387230f2aa0a331b4d96fba",
+	"DAI":                     "0x89d24a6b4ccb1b6faa2625fe562bdd9a23260359",
+	"DALC":                    "0x07d9e49ea402194bf48a8276dafb16e4ed633317",
+	"DAN":                     "0x9b70740e708a083c6ff38df52297020f5dfaa5ee",
+	"DAO":                     "0xbb9bc244d798123fde783fcc1c72d3bb8c189413",
+	"DAT":                     "0x81c9151de0c8bafcd325a57e3db5a5df1cebf79c",
+	"DATABroker":              "0x1b5f21ee98eed48d292e8e2d3ed82b40a9728a22",
+	"DATACoin":                "0x0cf0ee63788a0849fe5297f3407f701e122cc023",
+	"DCA":                     "0x386faa4703a34a7fdb19bec2e14fd427c9638416",
+	"DCL":                     "0x399a0e6fbeb3d74c85357439f4c8aed9678a5cbf",
+	"DCN":                     "0x08d32b0da63e2c3bcf8019c9c5d849d7a9d791e6",
+	"DDF":                     "0xcc4ef9eeaf656ac1a2ab886743e98e97e090ed38",
+	"DEB":                     "0x151202c9c18e495656f372281f493eb7698961d5",
+	"DENT":                    "0x3597bfd533a99c9aa083587b074434e61eb0a258",
+	"DGD":                     "0xe0b7927c4af23765cb51314a0e0521a9645f0e2a",
+	"DGPT":                    "0xf6cfe53d6febaeea051f400ff5fc14f0cbbdaca1",
+	"DGX":                     "0x55b9a11c2e8351b4ffc7b11561148bfac9977855",
+	"DICE":                    "0x2e071d2966aa7d8decb1005885ba1977d6038a65",
+	"DIVX":                    "0x13f11c9905a08ca76e3e853be63d4f0944326c72",
+	"DLT":                     "0x07e3c70653548b04f0a75970c1f81b4cbbfb606f",
+	"DMT":                     "0x2ccbff3a042c68716ed2a2cb0c544a9f1d1935e1",
+	"DNT":                     "0x0abdace70d3790235af448c88547603b945604ea",
+	"DNX":                     "0xe43e2041dc3786e166961ed9484a5539033d10fb",
+	"DOW":                     "0xeef6e90034eea89e31eb4b8eacd323f28a92eae4",
+	"DPP":                     "0x01b3ec4aae1b8729529beb4965f27d008788b0eb",
+	"DRGN":                    "0x419c4db4b9e25d6db2ad9691ccb832c8d9fda05e",
+	"DROP (dropil)":           "0x4672bad527107471cb5067a887f4656d585a8a31",
+	"DROP (droplex)":          "0x3c75226555fc496168d48b88df83b95f16771f37",
+	"DRP":                     "0x621d78f2ef2fd937bfca696cabaf9a779f59b3ed",
+	"DSC":                     "0x1e09bd8cadb441632e441db3e1d79909ee0a2256",
+	"DTR":                     "0xd234bf2410a0009df9c3c63b610c09738f18ccd7",
+	"DUBI":                    "0xd4cffeef10f60eca581b5e1146b5aca4194a4c3b",
+	"Devcon2 Token":           "0xdd94de9cfe063577051a5eb7465d08317d8808b6",
+	"EAGLE":                   "0x994f0dffdbae0bbf09b652d6f11a493fd33f42b9",
+	"ECN":                     "0xa578acc0cb7875781b7880903f4594d13cfa8b98",
+	"ECO2":                    "0x17f93475d2a978f527c3f7c44abf44adfba60d5c",
+	"EDG":                     "0x08711d3b02c8758f2fb3ab4e80228418a7f8e39c",
+	"EDO":                     "0xced4e93198734ddaff8492d525bd258d49eb388e",
+	"EDU":                     "0x5b26c5d0772e5bbac8b3182ae9a13f9bb2d03765",
+	"EHT":                     "0xf9f0fc7167c311dd2f1e21e9204f87eba9012fb2",
+	"ELF":                     "0xbf2179859fc6d5bee9bf9158632dc51678a4100e",
+	"ELIX":                    "0xc8c6a31a4a806d3710a7b38b7b296d2fabccdba8",
+	"ELTCOIN":                 "0x44197a4c44d6a059297caf6be4f7e172bd56caaf",
+	"EMON":                    "0xb67b88a25708a35ae7c2d736d398d268ce4f7f83",
+	"EMONT":                   "0x95daaab98046846bf4b2853e23cba236fa394a31",
+	"EMV":                     "0xb802b24e0637c2b87d2e8b7784c055bbe921011a",
+	"ENJ":                     "0xf629cbd94d3791c9250152bd8dfbdf380e2a3b9c",
+	"EOS":                     "0x86fa049857e0209aa7d9e616f7eb3b3b78ecfdb0",
+	"ESZ":                     "0xe8a1df958be379045e2b46a31a98b93a2ecdfded",
+	"ETBS":                    "0x1b9743f556d65e757c4c650b4555baf354cb8bd3",
+	"ETHB":                    "0x3a26746ddb79b1b8e4450e3f4ffe3285a307387e",
+	"EURT":                    "0xabdf147870235fcfc34153828c769a70b3fae01f",
+	"EVE":                     "0x923108a439c4e8c2315c4f6521e5ce95b44e9b4c",
+	"EVN":                     "0xd780ae2bf04cd96e577d3d014762f831d97129d0",
+	"EVX":                     "0xf3db5fa2c66b7af3eb0c0b782510816cbe4813b8",
+	"EXMR":                    "0xc98e0639c6d2ec037a615341c369666b110e80e5",
+	"E₹":                      "0xb67734521eabbe9c773729db73e16cc2dfb20a58",
+	"FAM":                     "0x190e569be071f40c704e15825f285481cb74b6cc",
+	"FKX":                     "0x009e864923b49263c7f10d19b7f8ab7a9a5aad33",
+	"FLIXX":                   "0xf04a8ac553fcedb5ba99a64799155826c136b0be",
+	"FLP":                     "0x3a1bda28adb5b0a812a7cf10a1950c920f79bcd3",
+	"FLUZ":                    "0x954b5de09a55e59755acbda29e1eb74a45d30175",
+	"FLX":                     "0x70b147e01e9285e7ce68b9ba437fe3a9190e756a",
+	"FND":                     "0x4df47b4969b2911c966506e3592c41389493953b",
+	"FRD":                     "0x0abefb7611cb3a01ea3fad85f33c3c934f8e2cf4",
+	"FTC":                     "0xe6f74dcfa0e20883008d8c16b6d9a329189d0c30",
+	"FTR":                     "0x2023dcf7c438c8c8c0b0f28dbae15520b4f3ee20",
+	"FUCK":                    "0xab16e0d25c06cb376259cc18c1de4aca57605589",
+	"FUEL":                    "0xea38eaa3c86c8f9b751533ba2e562deb9acded40",
+	"FUN":                     "0x419d0d8bdd9af5e606ae2232ed285aff190e711b",
+	"FYN":                     "0x88fcfbc22c6d3dbaa25af478c578978339bde77a",
+	"GAM":                     "0xf67451dc8421f0e0afeb52faa8101034ed081ed9",
+	"GAVEL":                   "0x708876f486e448ee89eb332bfbc8e593553058b9",
+	"GBT":                     "0x7585f835ae2d522722d2684323a0ba83401f32f5",
+	"GEE":                     "0x4f4f0db4de903b88f2b1a2847971e231d54f8fd3",
+	"GELD":                    "0x24083bb30072643c3bb90b44b7285860a755e687",
+	"GIM":                     "0xae4f56f072c34c0a65b3ae3e4db797d831439d93",
+	"GMT":                     "0xb3bd49e28f8f832b8d1e246106991e546c323502",
+	"GNO":                     "0x6810e776880c02933d47db1b9fc05908e5386b96",
+	"GNT":                     "0xa74476443119a942de498590fe1f2454d7d4ac0d",
+	"GOLDX":                   "0xeab43193cf0623073ca89db9b712796356fa7414",
+	"GRID":                    "0x12b19d3e2ccc14da04fae33e63652ce469b3f2fd",
+	"GTC":                     "0xb70835d7822ebb9426b56543e391846c107bd32c",
+	"GTKT":                    "0x025abad9e518516fdaafbdcdb9701b37fb7ef0fa",
+	"GUP":                     "0xf7b098298f7c69fc14610bf71d5e02c60792894c",
+	"GVT":                     "0x103c3a209da59d3e7c4a89307e66521e081cfdf0",
+	"GXC":                     "0x58ca3065c0f24c7c96aee8d6056b5b5decf9c2f8",
+	"GXVC":                    "0x22f0af8d78851b72ee799e05f54a77001586b18a",
+	"GZE":                     "0x8c65e992297d5f092a756def24f4781a280198ff",
+	"HAT":                     "0x9002d4485b7594e3e850f0a206713b305113f69e",
+	"HDG":                     "0xffe8196bc259e8dedc544d935786aa4709ec3e64",
+	"HGT":                     "0xba2184520a1cc49a6159c57e61e1844e085615b6",
+	"HIG":                     "0xa9240fbcac1f0b9a6adfb04a53c8e3b0cc1d1444",
+	"HKG":                     "0x14f37b574242d366558db61f3335289a5035c506",
+	"HMQ":                     "0xcbcc0f036ed4788f63fc0fee32873d6a7487b908",
+	"HODL":                    "0xb45d7bc4cebcab98ad09babdf8c818b2292b672c",
+	"HST":                     "0x554c20b7c486beee439277b4540a434566dc4c02",
+	"HVN":                     "0xc0eb85285d83217cd7c891702bcbc0fc401e2d9d",
+	"Hdp":                     "0xe9ff07809ccff05dae74990e25831d0bc5cbe575",
+	"Hdp.ф":                   "0x84543f868ec1b1fac510d49d13c069f64cd2d5f9",
+	"ICE":                     "0x5a84969bb663fb64f6d015dcf9f622aedc796750",
+	"ICN":                     "0x888666ca69e0f178ded6d75b5726cee99a87d698",
+	"ICO":                     "0xa33e729bf4fdeb868b534e1f20523463d9c46bee",
+	"ICOS":                    "0x014b50466590340d41307cc54dcee990c8d58aa8",
+	"ICX":                     "0xb5a5f22694352c15b00323844ad545abb2b11028",
+	"IDEA":                    "0x814cafd4782d2e728170fda68257983f03321c58",
+	"IFT":                     "0x7654915a1b82d6d2d0afc37c52af556ea8983c7e",
+	"IIC":                     "0x16662f73df3e79e54c6c5938b4313f92c524c120",
+	"IKB":                     "0x88ae96845e157558ef59e9ff90e766e22e480390",
+	"IMC":                     "0xe3831c5a982b279a198456d577cfb90424cb6340",
+	"IMT":                     "0x22e5f62d0fa19974749faa194e3d3ef6d89c08d7",
+	"IND":                     "0xf8e386eda857484f5a12e4b5daa9984e06e73705",
+	"INS":                     "0x5b2e4a700dfbc560061e957edec8f6eeeb74a320",
+	"INXT":                    "0xa8006c4ca56f24d6836727d106349320db7fef82",
+	"IPL":                     "0x64cdf819d3e75ac8ec217b3496d7ce167be42e80",
+	"ITC":                     "0x5e6b6d9abad9093fdc861ea1600eba1b355cd940",
+	"ITT":                     "0x0aef06dcccc531e581f0440059e6ffcc206039ee",
+	"IXT":                     "0xfca47962d45adfdfd1ab2d972315db4ce7ccf094",
+	"IoT":                     "0xc34b21f6f8e51cc965c2393b3ccfa3b82beb2403",
+	"JBX":                     "0x0aaf561eff5bd9c8f911616933f84166a17cfe0c",
+	"JET":                     "0x8727c112c712c4a03371ac87a74dd6ab104af768",
+	"JNT":                     "0xa5fd1a791c4dfcaacc963d4f73c6ae5824149ea7",
+	"JetCoins":                "0x773450335ed4ec3db45af74f34f2c85348645d39",
+	"KEE":                     "0x72d32ac1c5e66bfc5b08806271f8eef915545164",
+	"KEY":                     "0x4cc19356f2d37338b9802aa8e8fc58b0373296e7",
+	"KICK":                    "0x27695e09149adc738a978e9a678f99e4c39e9eb9",
+	"KIN":                     "0x818fc6c2ec5986bc6e2cbf00939d90556ab12ce5",
+	"KNC":                     "0xdd974d5c2e2928dea5f71b9825b8b646686bd200",
+	"KZN":                     "0x9541fd8b9b5fa97381783783cebf2f5fa793c262",
+	"LA":                      "0xe50365f5d679cb98a1dd62d6f6e58e59321bcddf",
+	"LEMO":                    "0xd6e354f07319e2474491d8c7c712137bee6862a2",
+	"LFR":                     "0xc798cd1c49db0e297312e4c682752668ce1db2ad",
+	"LGR":                     "0x2eb86e8fc520e0f6bb5d9af08f924fe70558ab89",
+	"LIF":                     "0xeb9951021698b42e4399f9cbb6267aa35f82d59d",
+	"LIFE":                    "0xff18dbc487b4c2e3222d115952babfda8ba52f5f",
+	"LINK (Chainlink)":        "0x514910771af9ca656af840dff83e8264ecf986ca",
+	"LINK Platform":           "0xe2e6d4be086c6938b53b22144855eef674281639",
+	"LIVE":                    "0x24a77c1f17c547105e14813e517be06b0040aa76",
+	"LNC":                     "0x63e634330a20150dbb61b15648bc73855d6ccf07",
+	"LNC-Linker Coin":         "0x6beb418fc6e1958204ac8baddcf109b8e9694966",
+	"LOC":                     "0x5e3346444010135322268a4630d2ed5f8d09446c",
+	"LOK":                     "0x21ae23b882a340a22282162086bc98d3e2b73018",
+	"LRC":                     "0xef68e7c694f40c8202821edf525de3782458639f",
+	"LUCK":                    "0xfb12e3cca983b9f59d90912fd17f8d745a8b2953",
+	"LUM":                     "0xa89b5934863447f6e4fc53b315a93e873bda69a3",
+	"LUN":                     "0xfa05a73ffe78ef8f1a739473e462c54bae6567d9",
+	"M-ETH":                   "0x3f4b726668da46f5e0e75aa5d478acec9f38210f",
+	"MANA":                    "0x0f5d2fb29fb7d3cfee444a200298f468908cc942",
+	"MBRS":                    "0x386467f1f3ddbe832448650418311a479eecfc57",
+	"MCAP":                    "0x93e682107d1e9defb0b5ee701c71707a4b2e46bc",
+	"MCI":                     "0x138a8752093f4f9a79aaedf48d4b9248fab93c9c",
+	"MCO":                     "0xb63b606ac810a52cca15e44bb630fd42d8d1d83d",
+	"MDA":                     "0x51db5ad35c671a87207d88fc11d593ac0c8415bd",
+	"MGO":                     "0x40395044ac3c0c57051906da938b54bd6557f212",
+	"MIT":                     "0xe23cd160761f63fc3a1cf78aa034b6cdf97d3e0c",
+	"MKR":                     "0x9f8f72aa9304c8b593d555f12ef6589cc3a579a2",
+	"MLN":                     "0xbeb9ef514a379b997e0798fdcc901ee474b6d9a1",
+	"MNE":                     "0x1a95b271b0535d15fa49932daba31ba612b52946",
+	"MNT":                     "0xa9877b1e05d035899131dbd1e403825166d09f92",
+	"MNTP":                    "0x83cee9e086a77e492ee0bb93c2b0437ad6fdeccc",
+	"MOD":                     "0x957c30ab0426e0c93cd8241e2c60392d08c6ac8e",
+	"MRP":                     "0x21f0f0fd3141ee9e11b3d7f13a1028cd515f459c",
+	"MRV":                     "0xab6cf87a50f17d7f5e1feaf81b6fe9ffbe8ebf84",
+	"MSP":                     "0x68aa3f232da9bdc2343465545794ef3eea5209bd",
+	"MTH":                     "0xaf4dce16da2877f8c9e00544c93b62ac40631f16",
+	"MTL":                     "0xf433089366899d83a9f26a773d59ec7ecf30355e",
+	"MTN":                     "0x41dbecc1cdc5517c6f76f6a6e836adbee2754de3",
+	"MTR":                     "0x7fc408011165760ee31be2bf20daf450356692af",
+	"MTRc":                    "0x1e49ff77c355a3e38d6651ce8404af0e48c5395f",
+	"MTX":                     "0x0af44e2784637218dd1d32a322d44e603a8f0c6a",
+	"MWAT":                    "0x6425c6be902d692ae2db752b3c268afadb099d3b",
+	"MYD":                     "0xf7e983781609012307f2514f63d526d83d24f466",
+	"MYST":                    "0xa645264c5603e96c3b0b078cdab68733794b0a71",
+	"NDC":                     "0xa54ddc7b3cce7fc8b1e3fa0256d0db80d2c10970",
+	"NET":                     "0xcfb98637bcae43c13323eaa1731ced2b716962fd",
+	"NEU":                     "0xa823e6722006afe99e91c30ff5295052fe6b8e32",
+	"NEWB":                    "0x814964b1bceaf24e26296d031eadf134a2ca4105",
+	"NGC":                     "0x72dd4b6bd852a3aa172be4d6c5a6dbec588cf131",
+	"NIMFA":                   "0xe26517a9967299453d3f1b48aa005e6127e67210",
+	"NMR":                     "0x1776e1f26f98b1a5df9cd347953a26dd3cb46671",
+	"NOX":                     "0xec46f8207d766012454c408de210bcbc2243e71c",
+	"NULS":                    "0xb91318f35bdb262e9423bc7c7c2a3a93dd93c92c",
+	"NXX":                     "0x7627de4b93263a6a7570b8dafa64bae812e5c394",
+	"NXX OLD":                 "0x5c6183d10a00cd747a6dbb5f658ad514383e9419",
+	"NxC":                     "0x45e42d659d9f9466cd5df622506033145a9b89bc",
+	"OAX":                     "0x701c244b988a513c945973defa05de933b23fe1d",
+	"OHNI":                    "0x7f2176ceb16dcb648dc924eff617c3dc2befd30d",
+	"OLD_MKR":                 "0xc66ea802717bfb9833400264dd12c2bceaa34a6d",
+	"OMG":                     "0xd26114cd6ee289accf82350c8d8487fedb8a0c07",
+	"ONEK":                    "0xb23be73573bc7e03db6e5dfc62405368716d28a8",
+	"OPT":                     "0x4355fc160f74328f9b383df2ec589bb3dfd82ba0",
+	"OST":                     "0x2c4e8f2d746113d0696ce89b35f0d8bf88e0aeca",
+	"Ox Fina":                 "0x65a15014964f2102ff58647e16a16a6b9e14bcf6",
+	"PARETO":                  "0xea5f88e54d982cbb0c441cde4e79bc305e5b43bc",
+	"PATENTS":                 "0x694404595e3075a942397f466aacd462ff1a7bd0",
+	"PAY":                     "0xb97048628db6b661d4c2aa833e95dbe1a905b280",
+	"PBL":                     "0x55648de19836338549130b1af587f16bea46f66b",
+	"PCH":                     "0xfcac7a7515e9a9d7619fa77a1fa738111f66727e",
+	"PCL":                     "0x3618516f45cd3c913f81f9987af41077932bc40d",
+	"PCLOLD":                  "0x53148bb4551707edf51a1e8d7a93698d18931225",
+	"PET":                     "0x5884969ec0480556e11d119980136a4c17edded1",
+	"PETRO":                   "0xec18f898b4076a3e18f1089d33376cc380bde61d",
+	"PEXT":                    "0x55c2a0c171d920843560594de3d6eecc09efc098",
+	"PIPL":                    "0xe64509f0bf07ce2d29a7ef19a8a9bc065477c1b4",
+	"PIX":                     "0x8effd494eb698cc399af6231fccd39e08fd20b15",
+	"PLASMA":                  "0x59416a25628a76b4730ec51486114c32e0b582a1",
+	"PLAY":                    "0xe477292f1b3268687a29376116b0ed27a9c76170",
+	"PLBT":                    "0x0affa06e7fbe5bc9a764c979aa66e8256a631f02",
+	"PLR":                     "0xe3818504c1b32bf1557b16c238b2e01fd3149c17",
+	"PLU":                     "0xd8912c10681d8b21fd3742244f44658dba12264e",
+	"POE":                     "0x0e0989b1f9b8a38983c2ba8053269ca62ec9b195",
+	"POIN":                    "0x43f6a1be992dee408721748490772b15143ce0a7",
+	"POLY":                    "0x9992ec3cf6a55b00978cddf2b27bc6882d88d1ec",
+	"POOL":                    "0x779b7b713c86e3e6774f5040d9ccc2d43ad375f8",
+	"POS":                     "0xee609fe292128cad03b786dbb9bc2634ccdbe7fc",
+	"POWR":                    "0x595832f8fc6bf59c85c527fec3740a1b7a361269",
+	"PPP":                     "0xc42209accc14029c1012fb5680d95fbd6036e2a0",
+	"PPT":                     "0xd4fa1460f537bb9085d22c7bccb5dd450ef28e3a",
+	"PRE":                     "0x88a3e4f35d64aad41a6d4030ac9afe4356cb84fa",
+	"PRG":                     "0x7728dfef5abd468669eb7f9b48a7f70a501ed29d",
+	"PRL":                     "0x1844b21593262668b7248d0f57a220caaba46ab9",
+	"PRO":                     "0x226bb599a12c826476e3a771454697ea52e9e220",
+	"PRPS":                    "0x7641b2ca9ddd58addf6e3381c1f994aac5f1a32f",
+	"PRS":                     "0x163733bcc28dbf26b41a8cfa83e369b5b3af741b",
+	"PRSP":                    "0x0c04d4f331da8df75f9e2e271e3f3f1494c66c36",
+	"PT":                      "0x66497a283e0a007ba3974e837784c6ae323447de",
+	"PTOY":                    "0x8ae4bf2c33a8e667de34b54938b0ccd03eb8cc06",
+	"PTWO":                    "0x5512e1d6a7be424b4323126b4f9e86d023f95764",
+	"PUC":                     "0xef6b4ce8c9bc83744fbcde2657b32ec18790458a",
+	"PXT":                     "0xc14830e53aa344e8c14603a91229a0b925b0b262",
+	"QAU":                     "0x671abbe5ce652491985342e85428eb1b07bc6c64",
+	"QRL":                     "0x697beac28b09e122c4332d163985e8a73121b97f",
+	"QSP":                     "0x99ea4db9ee77acd40b119bd1dc4e33e1c070b80d",
+	"QTQ":                     "0x2c3c1f05187dba7a5f2dd47dca57281c4d4f183f",
+	"QTUM":                    "0x9a642d6b3368ddc662ca244badf32cda716005bc",
+	"RCT":                     "0x2a3aa9eca41e720ed46b5a70d6c37efa47f768ac",
+	"RDN":                     "0x255aa6df07540cb5d3d297f0d0d4d84cb52bc8e6",
+	"REA":                     "0x767ba2915ec344015a7938e3eedfec2785195d05",
+	"REBL":                    "0x5f53f7a8075614b699baad0bc2c899f4bad8fbbf",
+	"REN":                     "0x408e41876cccdc0f92210600ef50372656052a38",
+	"REP":                     "0xe94327d07fc17907b4db788e5adf2ed424addff6",
+	"REQ":                     "0x8f8221afbb33998d8584a2b05749ba73c37a938a",
+	"REX":                     "0xf05a9382a4c3f29e2784502754293d88b835109c",
+	"RIPT":                    "0xdd007278b667f6bef52fd0a4c23604aa1f96039a",
+	"RLC":                     "0x607f4c5bb672230e8672085532f7e901544a7375",
+	"RLT":                     "0xcced5b8288086be8c38e23567e684c3740be4d48",
+	"RLTY":                    "0xbe99b09709fc753b09bcf557a992f6605d5997b0",
+	"RLX":                     "0x4a42d2c580f83dce404acad18dab26db11a1750e",
+	"RNDR":                    "0x0996bfb5d057faa237640e2506be7b4f9c46de0b",
+	"ROK":                     "0xc9de4b7f0c3d991e967158e4d4bfa4b51ec0b114",
+	"ROUND":                   "0x4993cb95c7443bdc06155c5f5688be9d8f6999a5",
+	"RPL":                     "0xb4efd85c19999d84251304bda99e90b92300bd93",
+	"RTN":                     "0x54b293226000ccbfc04df902eec567cb4c35a903",
+	"RVL":                     "0x41f615e24fabd2b097a320e9e6c1f448cb40521c",
+	"RVT":                     "0x3d1ba9be9f66b8ee101911bc36d3fb562eac2244",
+	"S-A-PAT":                 "0x1ec8fe51a9b6a3a6c427d17d9ecc3060fbc4a45c",
+	"S-ETH":                   "0x3eb91d237e491e0dee8582c402d85cb440fb6b54",
+	"SALT":                    "0x4156d3342d5c385a87d264f90653733592000581",
+	"SAN":                     "0x7c5a0ce9267ed19b22f8cae653f198e3e8daf098",
+	"SCANDI":                  "0x78fe18e41f436e1981a3a60d1557c8a7a9370461",
+	"SCL":                     "0xd7631787b4dcc87b1254cfd1e5ce48e96823dee8",
+	"SENSE":                   "0x6745fab6801e376cd24f03572b9c9b0d4edddccf",
+	"SET":                     "0xe06eda7435ba749b047380ced49121dde93334ae",
+	"SEXY":                    "0x98f5e9b7f0e33956c0443e81bf7deb8b5b1ed545",
+	"SGEL":                    "0xa1ccc166faf0e998b3e33225a1a0301b1c86119d",
+	"SGT":                     "0xd248b0d48e44aaf9c49aea0312be7e13a6dc1468",
+	"SHIT":                    "0xef2e9966eb61bb494e5375d5df8d67b7db8a780d",
+	"SIFT":                    "0x8a187d5285d316bcbc9adafc08b51d70a0d8e000",
+	"SKIN":                    "0x2bdc0d42996017fce214b21607a515da41a9e0c5",
+	"SKO1":                    "0x4994e81897a920c0fea235eb8cedeed3c6fff697",
+	"SKR":                     "0x4c382f8e09615ac86e08ce58266cc227e7d4d913",
+	"SKRP":                    "0x6e34d8d84764d40f6d7b39cd569fd017bf53177d",
+	"SLT":                     "0x7a5ff295dc8239d5c2374e4d894202aaf029cab6",
+	"SMART":                   "0x6f6deb5db0c4994a8283a01d6cfeeb27fc3bbe9c",
+	"SMT":                     "0x2dcfaac11c9eebd8c6c42103fe9e2a6ad237af27",
+	"SNC":                     "0xf4134146af2d511dd5ea8cdb1c4ac88c57d60404",
+	"SND":                     "0xf333b2ace992ac2bbd8798bf57bc65a06184afba",
+	"SNG":                     "0xcfd6ae8bf13f42de14867351eaff7a8a3b9fbbe7",
+	"SNGLS":                   "0xaec2e87e0a235266d9c5adc9deb4b2e29b54d009",
+	"SNIP":                    "0x44f588aeeb8c44471439d1270b3603c66a9262f1",
+	"SNM":                     "0x983f6d60db79ea8ca4eb9968c6aff8cfa04b3c63",
+	"SNOV":                    "0xbdc5bac39dbe132b1e030e898ae3830017d7d969",
+	"SNT":                     "0x744d70fdbe2ba4cf95131626614a1763df805b9e",
+	"SOL":                     "0x1f54638b7737193ffd86c19ec51907a7c41755d8",
+	"SPANK":                   "0x42d6622dece394b54999fbd73d108123806f6a18",
+	"SPARC":                   "0x58bf7df57d9da7113c4ccb49d8463d4908c735cb",
+	"SPARTA":                  "0x24aef3bf1a47561500f9430d74ed4097c47f51f2",
+	"SPF":                     "0x85089389c14bd9c77fc2b8f0c3d1dc3363bf06ef",
+	"SRN":                     "0x68d57c9a1c35f63e2c83ee8e49a64e9d70528d25",
+	"STAC":                    "0x9a005c9a89bd72a4bd27721e7a09a3c11d2b03c4",
+	"STAR":                    "0xf70a642bd387f94380ffb90451c2c81d4eb82cbc",
+	"STC":                     "0x629aee55ed49581c33ab27f9403f7992a289ffd5",
+	"STK":                     "0xae73b38d1c9a8b274127ec30160a4927c4d71824",
+	"STN":                     "0x599346779e90fc3f5f997b5ea715349820f91571",
+	"STORJ":                   "0xb64ef51c888972c908cfacf59b47c1afbc0ab8ac",
+	"STORM":                   "0xd0a4b8946cb52f0661273bfbc6fd0e0c75fc6433",
+	"STP":                     "0xecd570bbf74761b960fa04cc10fe2c4e86ffda36",
+	"STRC":                    "0x46492473755e8df960f8034877f61732d718ce96",
+	"STX":                     "0x006bea43baa3f7a6f765f14f10a1a1b08334ef45",
+	"SUB":                     "0x12480e24eb5bec1a9d4369cab6a80cad3c0a377a",
+	"SWM":                     "0x9e88613418cf03dca54d6a2cf6ad934a78c7a17a",
+	"SWT":                     "0xb9e7f8568e08d5659f5d29c4997173d84cdf2607",
+	"SXDT":                    "0x12b306fa98f4cbb8d4457fdff3a0a0a56f07ccdf",
+	"SXUT":                    "0x2c82c73d5b34aa015989462b2948cd616a37641f",
+	"SYN":                     "0x10b123fddde003243199aad03522065dc05827a0",
+	"SenSatorI":               "0x4ca74185532dc1789527194e5b9c866dd33f4e82",
+	"TAU":                     "0xc27a2f05fa577a83ba0fdb4c38443c0718356501",
+	"TBC2":                    "0xfaccd5fc83c3e4c3c1ac1ef35d15adf06bcf209c",
+	"TBT":                     "0xafe60511341a37488de25bef351952562e31fcc1",
+	"TEL":                     "0x85e076361cc813a908ff672f9bad1541474402b2",
+	"TFL":                     "0xa7f976c360ebbed4465c2855684d1aae5271efa9",
+	"TIME":                    "0x6531f133e6deebe7f2dce5a0441aa7ef330b4e53",
+	"TIO":                     "0x80bc5512561c7f85a3a9508c7df7901b370fa1df",
+	"TIX":                     "0xea1f346faf023f974eb5adaf088bbcdf02d761f4",
+	"TKN":                     "0xaaaf91d9b90df800df4f55c205fd6989c977e73a",
+	"TNT":                     "0x08f5a9235b08173b7569f83645d2c7fb55e8ccd8",
+	"TRC":                     "0xcb3f902bf97626391bf8ba87264bbc3dc13469be",
+	"TRCN":                    "0x566fd7999b1fc3988022bd38507a48f0bcf22c77",
+	"TRST":                    "0xcb94be6f13a1182e4a4b6140cb7bf2025d28e41b",
+	"TRX":                     "0xf230b790e05390fc8295f4d3f60332c93bed42e2",
+	"TWN":                     "0x2ef1ab8a26187c58bb8aaeb11b2fc6d25c5c0716",
+	"TWNKL":                   "0xfbd0d1c77b501796a35d86cf91d65d9778eee695",
+	"TaaS":                    "0xe7775a6e9bcf904eb39da2b68c5efb4f9360e08c",
+	"UKG":                     "0x24692791bc444c5cd0b81e3cbcaba4b04acd1f3b",
+	"UQC":                     "0xd01db73e047855efb414e6202098c4be4cd2423b",
+	"USDT":                    "0xdac17f958d2ee523a2206206994597c13d831ec7",
+	"UTK":                     "0x70a72833d6bf7f508c8224ce59ea1ef3d0ea3a38",
+	"UTN-P":                   "0x9e3319636e2126e3c0bc9e3134aec5e1508a46c7",
+	"Unicorn":                 "0x89205a3a3b2a69de6dbf7f01ed13b2108b2c43e7",
+	"VEE":                     "0x340d2bde5eb28c1eed91b2f790723e3b160613b7",
+	"VENUS":                   "0xebed4ff9fe34413db8fc8294556bbd1528a4daca",
+	"VERI":                    "0x8f3470a7388c05ee4e7af3d01d8c722b0ff52374",
+	"VET":                     "0xd850942ef8811f2a866692a623011bde52a462c1",
+	"VIB":                     "0x2c974b2d0ba1716e644c1fc59982a89ddd2ff724",
+	"VIBE":                    "0xe8ff5c9c75deb346acac493c463c8950be03dfba",
+	"VIBEX":                   "0x882448f83d90b2bf477af2ea79327fdea1335d93",
+	"VIU":                     "0x519475b31653e46d20cd09f9fdcf3b12bdacb4f5",
+	"VOISE":                   "0x83eea00d838f92dec4d1475697b9f4d3537b56e3",
+	"VRS":                     "0xedbaf3c5100302dcdda53269322f3730b1f0416d",
+	"VSL":                     "0x5c543e7ae0a1104f78406c340e9c64fd9fce5170",
+	"WAX":                     "0x39bb259f66e1c59d5abef88375979b4d20d98022",
+	"WBA":                     "0x74951b677de32d596ee851a233336926e6a2cd09",
+	"WCT":                     "0x6a0a97e47d15aad1d132a1ac79a480e3f2079063",
+	"WETH":                    "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2",
+	"WHO":                     "0xe933c0cd9784414d5f278c114904f5a84b396919",
+	"WIC":                     "0x62cd07d414ec50b68c7ecaa863a23d344f2d062f",
+	"WILD":                    "0xd3c00772b24d997a812249ca637a921e81357701",
+	"WINGS":                   "0x667088b212ce3d06a1b553a7221e1fd19000d9af",
+	"WLK":                     "0xf6b55acbbc49f4524aa48d19281a9a77c54de10f",
+	"WOLK":                    "0x728781e75735dc0962df3a51d7ef47e798a7107e",
+	"WPC":                     "0x62087245087125d3db5b9a3d713d78e7bbc31e54",
+	"WPR":                     "0x4cf488387f035ff08c371515562cba712f9015d4",
+	"WRK":                     "0x71e8d74ff1c923e369d0e70dfb09866629c4dd35",
+	"WYV":                     "0x056017c55ae7ae32d12aef7c679df83a85ca75ff",
+	"WaBi":                    "0x286bda1413a2df81731d4930ce2f862a35a609fe",
+	"WiC":                     "0x5e4abe6419650ca839ce5bb7db422b881a6064bb",
+	"X8X":                     "0x910dfc18d6ea3d6a7124a6f8b5458f281060fa4c",
+	"XAUR":                    "0x4df812f6064def1e5e029f1ca858777cc98d2d81",
+	"XCC":                     "0x4d829f8c92a6691c56300d020c9e0db984cfe2ba",
+	"XGM":                     "0x533ef0984b2faa227acc620c67cce12aa39cd8cd",
+	"XGT":                     "0x30f4a3e0ab7a76733d8b60b89dd93c3d0b4c9e2f",
+	"XID":                     "0xb110ec7b1dcb8fab8dedbf28f53bc63ea5bedd84",
+	"XNN":                     "0xab95e915c123fded5bdfb6325e35ef5515f1ea69",
+	"XNT":                     "0x572e6f318056ba0c5d47a422653113843d250691",
+	"XRL":                     "0xb24754be79281553dc1adc160ddf5cd9b74361a4",
+	"XSC":                     "0x0f513ffb4926ff82d7f60a05069047aca295c413",
+	"YUPIE":                   "0x0f33bb20a282a7649c7b3aff644f084a9348e933",
+	"ZAP":                     "0x6781a0f84c7e9e846dcb84a9a5bd49333067b104",
+	"ZIL":                     "0x05f4a42e251f2d52b8ed15e9fedaacfcef1fad27",
+	"ZRX":                     "0xe41d2489571d322189246dafa5ebde1f4699f498",
+	"ZST":                     "0xe386b139ed3715ca4b18fd52671bdcea1cdfe4b1",
+	"cV":                      "0xda6cb58a0d0c01610a29c5a65c303e13e885887c",
+	"eBCH":                    "0xafc39788c51f0c1ff7b55317f3e70299e521fff6",
+	"eBTC":                    "0xeb7c20027172e5d143fb030d50f91cece2d1485d",
+	"eGAS":                    "0xb53a96bcbdd9cf78dff20bab6c2be7baec8f00f8",
+	"onG":                     "0xd341d1680eeee3255b8c4c75bcce7eb57f144dae",
+}
 
-		"LIFE":                    "0xff18dbc487b4c2e3222d115952babfda8ba52f5f",
-		"YUPIE":                   "0x0f33bb20a282a7649c7b3aff644f084a9348e933",
-		"AGI":                     "0x8eb24319393716668d768dcec29356ae9cffe285",
-		"BTL (Battle)":            "0x2accab9cb7a48c3e82286f0b2f8798d201f4ec3f",
-		"DIVX":                    "0x13f11c9905a08ca76e3e853be63d4f0944326c72",
-		"EOS":                     "0x86fa049857e0209aa7d9e616f7eb3b3b78ecfdb0",
-		"CPY":                     "0xf44745fbd41f6a1ba151df190db0564c5fcc4410",
-		"WLK":                     "0xf6b55acbbc49f4524aa48d19281a9a77c54de10f",
-		"TAU":                     "0xc27a2f05fa577a83ba0fdb4c38443c0718356501",
-		"DROP (dropil)":           "0x4672bad527107471cb5067a887f4656d585a8a31",
-		"SIFT":                    "0x8a187d5285d316bcbc9adafc08b51d70a0d8e000",
-		"MANA":                    "0x0f5d2fb29fb7d3cfee444a200298f468908cc942",
-		"BSDC":                    "0xf26ef5e0545384b7dcc0f297f2674189586830df",
-		"SPF":                     "0x85089389c14bd9c77fc2b8f0c3d1dc3363bf06ef",
-		"eBCH":                    "0xafc39788c51f0c1ff7b55317f3e70299e521fff6",
-		"EHT":                     "0xf9f0fc7167c311dd2f1e21e9204f87eba9012fb2",
-		"ONEK":                    "0xb23be73573bc7e03db6e5dfc62405368716d28a8",
-		"WRK":                     "0x71e8d74ff1c923e369d0e70dfb09866629c4dd35",
-		"POIN":                    "0x43f6a1be992dee408721748490772b15143ce0a7",
-		"BTL (Bitlle)":            "0x92685e93956537c25bb75d5d47fca4266dd628b8",
-		"REBL":                    "0x5f53f7a8075614b699baad0bc2c899f4bad8fbbf",
-		"GIM":                     "0xae4f56f072c34c0a65b3ae3e4db797d831439d93",
-		"PATENTS":                 "0x694404595e3075a942397f466aacd462ff1a7bd0",
-		"SNG":                     "0xcfd6ae8bf13f42de14867351eaff7a8a3b9fbbe7",
-		"SND":                     "0xf333b2ace992ac2bbd8798bf57bc65a06184afba",
-		"SNC":                     "0xf4134146af2d511dd5ea8cdb1c4ac88c57d60404",
-		"JNT":                     "0xa5fd1a791c4dfcaacc963d4f73c6ae5824149ea7",
-		"SNGLS":                   "0xaec2e87e0a235266d9c5adc9deb4b2e29b54d009",
-		"SNM":                     "0x983f6d60db79ea8ca4eb9968c6aff8cfa04b3c63",
-		"SNT":                     "0x744d70fdbe2ba4cf95131626614a1763df805b9e",
-		"PRPS":                    "0x7641b2ca9ddd58addf6e3381c1f994aac5f1a32f",
-		"WILD":                    "0xd3c00772b24d997a812249ca637a921e81357701",
-		"EVN":                     "0xd780ae2bf04cd96e577d3d014762f831d97129d0",
-		"CMC":                     "0x7e667525521cf61352e2e01b50faaae7df39749a",
-		"EVE":                     "0x923108a439c4e8c2315c4f6521e5ce95b44e9b4c",
-		"BEE":                     "0x4d8fc1453a0f359e99c9675954e656d80d996fbf",
-		"EVX":                     "0xf3db5fa2c66b7af3eb0c0b782510816cbe4813b8",
-		"PCH":                     "0xfcac7a7515e9a9d7619fa77a1fa738111f66727e",
-		"GUP":                     "0xf7b098298f7c69fc14610bf71d5e02c60792894c",
-		"PCL":                     "0x3618516f45cd3c913f81f9987af41077932bc40d",
-		"DTR":                     "0xd234bf2410a0009df9c3c63b610c09738f18ccd7",
-		"CMT":                     "0xf85feea2fdd81d51177f6b8f35f0e6734ce45f5f",
-		"BET":                     "0x8aa33a7899fcc8ea5fbe6a608a109c3893a1b8b2",
-		"WOLK":                    "0x728781e75735dc0962df3a51d7ef47e798a7107e",
-		"PTWO":                    "0x5512e1d6a7be424b4323126b4f9e86d023f95764",
-		"DCN":                     "0x08d32b0da63e2c3bcf8019c9c5d849d7a9d791e6",
-		"FTR":                     "0x2023dcf7c438c8c8c0b0f28dbae15520b4f3ee20",
-		"BLT":                     "0x107c4504cd79c5d2696ea0030a8dd4e92601b82e",
-		"TRCN":                    "0x566fd7999b1fc3988022bd38507a48f0bcf22c77",
-		"FTC":                     "0xe6f74dcfa0e20883008d8c16b6d9a329189d0c30",
-		"DSC":                     "0x1e09bd8cadb441632e441db3e1d79909ee0a2256",
-		"INS":                     "0x5b2e4a700dfbc560061e957edec8f6eeeb74a320",
-		"NOX":                     "0xec46f8207d766012454c408de210bcbc2243e71c",
-		"IND":                     "0xf8e386eda857484f5a12e4b5daa9984e06e73705",
-		"CTT":                     "0xe3fa177acecfb86721cf6f9f4206bd3bd672d7d5",
-		"REX":                     "0xf05a9382a4c3f29e2784502754293d88b835109c",
-		"CARCO":                   "0x2108e62d335bbdc89ec3e9d8582f18dcfb0cdff4",
-		"LOC":                     "0x5e3346444010135322268a4630d2ed5f8d09446c",
-		"REP":                     "0xe94327d07fc17907b4db788e5adf2ed424addff6",
-		"REQ":                     "0x8f8221afbb33998d8584a2b05749ba73c37a938a",
-		"CTX":                     "0x662abcad0b7f345ab7ffb1b1fbb9df7894f18e66",
-		"FUCK":                    "0xab16e0d25c06cb376259cc18c1de4aca57605589",
-		"POLY":                    "0x9992ec3cf6a55b00978cddf2b27bc6882d88d1ec",
-		"CTF":                     "0x4545750f39af6be4f237b6869d4ecca928fd5a85",
-		"COSS":                    "0x65292eeadf1426cd2df1c4793a3d7519f253913b",
-		"CTL":                     "0xbf4cfd7d1edeeea5f6600827411b41a21eb08abd",
-		"REA":                     "0x767ba2915ec344015a7938e3eedfec2785195d05",
-		"DOW":                     "0xeef6e90034eea89e31eb4b8eacd323f28a92eae4",
-		"POWR":                    "0x595832f8fc6bf59c85c527fec3740a1b7a361269",
-		"QTUM":                    "0x9a642d6b3368ddc662ca244badf32cda716005bc",
-		"MDA":                     "0x51db5ad35c671a87207d88fc11d593ac0c8415bd",
-		"SPARC":                   "0x58bf7df57d9da7113c4ccb49d8463d4908c735cb",
-		"LFR":                     "0xc798cd1c49db0e297312e4c682752668ce1db2ad",
-		"VET":                     "0xd850942ef8811f2a866692a623011bde52a462c1",
-		"EURT":                    "0xabdf147870235fcfc34153828c769a70b3fae01f",
-		"JET":                     "0x8727c112c712c4a03371ac87a74dd6ab104af768",
-		"XCC":                     "0x4d829f8c92a6691c56300d020c9e0db984cfe2ba",
-		"MCAP":                    "0x93e682107d1e9defb0b5ee701c71707a4b2e46bc",
-		"UTN-P":                   "0x9e3319636e2126e3c0bc9e3134aec5e1508a46c7",
-		"1ST":                     "0xaf30d2a7e90d7dc361c8c4585e9bb7d2f6f15bc7",
-		"CAT (Blockcat)":          "0x56ba2ee7890461f463f7be02aac3099f6d5811a8",
-		"EDG":                     "0x08711d3b02c8758f2fb3ab4e80228418a7f8e39c",
-		"Hdp.ф":                   "0x84543f868ec1b1fac510d49d13c069f64cd2d5f9",
-		"EDO":                     "0xced4e93198734ddaff8492d525bd258d49eb388e",
-		"RLC":                     "0x607f4c5bb672230e8672085532f7e901544a7375",
-		"PUC":                     "0xef6b4ce8c9bc83744fbcde2657b32ec18790458a",
-		"RLX":                     "0x4a42d2c580f83dce404acad18dab26db11a1750e",
-		"EDU":                     "0x5b26c5d0772e5bbac8b3182ae9a13f9bb2d03765",
-		"RLT":                     "0xcced5b8288086be8c38e23567e684c3740be4d48",
-		"CMBT":                    "0x3edd235c3e840c1f29286b2e39370a255c7b6fdb",
-		"BAT":                     "0x0d8775f648430679a709e98d2b0cb6250d2887ef",
-		"LINK Platform":           "0xe2e6d4be086c6938b53b22144855eef674281639",
-		"DPP":                     "0x01b3ec4aae1b8729529beb4965f27d008788b0eb",
-		"CAS":                     "0xe8780b48bdb05f928697a5e8155f672ed91462f7",
-		"CAR":                     "0x423e4322cdda29156b49a17dfbd2acc4b280600d",
-		"EMON":                    "0xb67b88a25708a35ae7c2d736d398d268ce4f7f83",
-		"RNDR":                    "0x0996bfb5d057faa237640e2506be7b4f9c46de0b",
-		"PETRO":                   "0xec18f898b4076a3e18f1089d33376cc380bde61d",
-		"CAN":                     "0x1d462414fe14cf489c7a21cac78509f4bf8cd7c0",
-		"RIPT":                    "0xdd007278b667f6bef52fd0a4c23604aa1f96039a",
-		"UKG":                     "0x24692791bc444c5cd0b81e3cbcaba4b04acd1f3b",
-		"CAG":                     "0x7d4b8cce0591c9044a22ee543533b72e976e36c3",
-		"MWAT":                    "0x6425c6be902d692ae2db752b3c268afadb099d3b",
-		"LA":                      "0xe50365f5d679cb98a1dd62d6f6e58e59321bcddf",
-		"ETBS":                    "0x1b9743f556d65e757c4c650b4555baf354cb8bd3",
-		"GNO":                     "0x6810e776880c02933d47db1b9fc05908e5386b96",
-		"WETH":                    "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2",
-		"PLBT":                    "0x0affa06e7fbe5bc9a764c979aa66e8256a631f02",
-		"IIC":                     "0x16662f73df3e79e54c6c5938b4313f92c524c120",
-		"HIG":                     "0xa9240fbcac1f0b9a6adfb04a53c8e3b0cc1d1444",
-		"LINK (Chainlink)":        "0x514910771af9ca656af840dff83e8264ecf986ca",
-		"eBTC":                    "0xeb7c20027172e5d143fb030d50f91cece2d1485d",
-		"S-A-PAT":                 "0x1ec8fe51a9b6a3a6c427d17d9ecc3060fbc4a45c",
-		"WCT":                     "0x6a0a97e47d15aad1d132a1ac79a480e3f2079063",
-		"STC":                     "0x629aee55ed49581c33ab27f9403f7992a289ffd5",
-		"HGT":                     "0xba2184520a1cc49a6159c57e61e1844e085615b6",
-		"HODL":                    "0xb45d7bc4cebcab98ad09babdf8c818b2292b672c",
-		"STK":                     "0xae73b38d1c9a8b274127ec30160a4927c4d71824",
-		"GXVC":                    "0x22f0af8d78851b72ee799e05f54a77001586b18a",
-		"STX":                     "0x006bea43baa3f7a6f765f14f10a1a1b08334ef45",
-		"SMART":                   "0x6f6deb5db0c4994a8283a01d6cfeeb27fc3bbe9c",
-		"TFL":                     "0xa7f976c360ebbed4465c2855684d1aae5271efa9",
-		"CFI":                     "0x12fef5e57bf45873cd9b62e9dbd7bfb99e32d73e",
-		"CRED":                    "0x672a1ad4f667fb18a333af13667aa0af1f5b5bdd",
-		"NIMFA":                   "0xe26517a9967299453d3f1b48aa005e6127e67210",
-		"WiC":                     "0x5e4abe6419650ca839ce5bb7db422b881a6064bb",
-		"LIF":                     "0xeb9951021698b42e4399f9cbb6267aa35f82d59d",
-		"QRL":                     "0x697beac28b09e122c4332d163985e8a73121b97f",
-		"FND":                     "0x4df47b4969b2911c966506e3592c41389493953b",
-		"VIBEX":                   "0x882448f83d90b2bf477af2ea79327fdea1335d93",
-		"CCC (CryptoCrashCourse)": "0x28577a6d31559bd265ce3adb62d0458550f7b8a7",
-		"MIT":             "0xe23cd160761f63fc3a1cf78aa034b6cdf97d3e0c",
-		"XNT":             "0x572e6f318056ba0c5d47a422653113843d250691",
-		"PRE":             "0x88a3e4f35d64aad41a6d4030ac9afe4356cb84fa",
-		"PRG":             "0x7728dfef5abd468669eb7f9b48a7f70a501ed29d",
-		"INXT":            "0xa8006c4ca56f24d6836727d106349320db7fef82",
-		"SCANDI":          "0x78fe18e41f436e1981a3a60d1557c8a7a9370461",
-		"PRL":             "0x1844b21593262668b7248d0f57a220caaba46ab9",
-		"PRO":             "0x226bb599a12c826476e3a771454697ea52e9e220",
-		"E₹":              "0xb67734521eabbe9c773729db73e16cc2dfb20a58",
-		"CCC (ICONOMI)":   "0xbe11eeb186e624b8f26a5045575a1340e4054552",
-		"PRS":             "0x163733bcc28dbf26b41a8cfa83e369b5b3af741b",
-		"GAVEL":           "0x708876f486e448ee89eb332bfbc8e593553058b9",
-		"XAUR":            "0x4df812f6064def1e5e029f1ca858777cc98d2d81",
-		"1WO":             "0xfdbc1adc26f0f8f8606a5d63b7d3a3cd21c22b23",
-		"MTRc":            "0x1e49ff77c355a3e38d6651ce8404af0e48c5395f",
-		"MRV":             "0xab6cf87a50f17d7f5e1feaf81b6fe9ffbe8ebf84",
-		"FAM":             "0x190e569be071f40c704e15825f285481cb74b6cc",
-		"MRP":             "0x21f0f0fd3141ee9e11b3d7f13a1028cd515f459c",
-		"PCLOLD":          "0x53148bb4551707edf51a1e8d7a93698d18931225",
-		"ZIL":             "0x05f4a42e251f2d52b8ed15e9fedaacfcef1fad27",
-		"CryptoCarbon":    "0xe4c94d45f7aef7018a5d66f44af780ec6023378e",
-		"VIB":             "0x2c974b2d0ba1716e644c1fc59982a89ddd2ff724",
-		"BeerCoin":        "0x74c1e4b8cae59269ec1d85d3d4f324396048f4ac",
-		"SXDT":            "0x12b306fa98f4cbb8d4457fdff3a0a0a56f07ccdf",
-		"APT":             "0x23ae3c5b39b12f0693e05435eeaa1e51d8c61530",
-		"ECO2":            "0x17f93475d2a978f527c3f7c44abf44adfba60d5c",
-		"KICK":            "0x27695e09149adc738a978e9a678f99e4c39e9eb9",
-		"IFT":             "0x7654915a1b82d6d2d0afc37c52af556ea8983c7e",
-		"STAR":            "0xf70a642bd387f94380ffb90451c2c81d4eb82cbc",
-		"NGC":             "0x72dd4b6bd852a3aa172be4d6c5a6dbec588cf131",
-		"HDG":             "0xffe8196bc259e8dedc544d935786aa4709ec3e64",
-		"STAC":            "0x9a005c9a89bd72a4bd27721e7a09a3c11d2b03c4",
-		"FUN":             "0x419d0d8bdd9af5e606ae2232ed285aff190e711b",
-		"BMC":             "0xdf6ef343350780bf8c3410bf062e0c015b1dd671",
-		"TKN":             "0xaaaf91d9b90df800df4f55c205fd6989c977e73a",
-		"RTN":             "0x54b293226000ccbfc04df902eec567cb4c35a903",
-		"HMQ":             "0xcbcc0f036ed4788f63fc0fee32873d6a7487b908",
-		"DENT":            "0x3597bfd533a99c9aa083587b074434e61eb0a258",
-		"TaaS":            "0xe7775a6e9bcf904eb39da2b68c5efb4f9360e08c",
-		"BMT":             "0xf028adee51533b1b47beaa890feb54a457f51e89",
-		"ELTCOIN":         "0x44197a4c44d6a059297caf6be4f7e172bd56caaf",
-		"BMX":             "0x986ee2b944c42d017f52af21c4c69b84dbea35d8",
-		"STN":             "0x599346779e90fc3f5f997b5ea715349820f91571",
-		"ENJ":             "0xf629cbd94d3791c9250152bd8dfbdf380e2a3b9c",
-		"DLT":             "0x07e3c70653548b04f0a75970c1f81b4cbbfb606f",
-		"VENUS":           "0xebed4ff9fe34413db8fc8294556bbd1528a4daca",
-		"ZRX":             "0xe41d2489571d322189246dafa5ebde1f4699f498",
-		"BTT":             "0x080aa07e2c7185150d7e4da98838a8d2feac3dfc",
-		"BTQ":             "0x16b0e62ac13a2faed36d18bce2356d25ab3cfad3",
-		"DROP (droplex)":  "0x3c75226555fc496168d48b88df83b95f16771f37",
-		"GZE":             "0x8c65e992297d5f092a756def24f4781a280198ff",
-		"MGO":             "0x40395044ac3c0c57051906da938b54bd6557f212",
-		"TBT":             "0xafe60511341a37488de25bef351952562e31fcc1",
-		"BTE":             "0x73dd069c299a5d691e9836243bcaec9c8c1d8734",
-		"CCLC":            "0xd348e07a2806505b856123045d27aeed90924b50",
-		"PTOY":            "0x8ae4bf2c33a8e667de34b54938b0ccd03eb8cc06",
-		"TIME":            "0x6531f133e6deebe7f2dce5a0441aa7ef330b4e53",
-		"BTM":             "0xcb97e65f07da24d46bcdd078ebebd7c6e6e3d750",
-		"GRID":            "0x12b19d3e2ccc14da04fae33e63652ce469b3f2fd",
-		"PLASMA":          "0x59416a25628a76b4730ec51486114c32e0b582a1",
-		"BTH":             "0xfad572db566e5234ac9fc3d570c4edc0050eaa92",
-		"AMIS":            "0x949bed886c739f1a3273629b3320db0c5024c719",
-		"WYV":             "0x056017c55ae7ae32d12aef7c679df83a85ca75ff",
-		"ADST":            "0x422866a8f0b032c5cf1dfbdef31a20f4509562b0",
-		"ATL":             "0x78b7fada55a64dd895d8c8c35779dd8b67fa8a05",
-		"IDEA":            "0x814cafd4782d2e728170fda68257983f03321c58",
-		"ATH":             "0x17052d51e954592c1046320c2371abab6c73ef10",
-		"JBX":             "0x0aaf561eff5bd9c8f911616933f84166a17cfe0c",
-		"ATT":             "0x887834d3b8d450b6bab109c252df3da286d73ce4",
-		"LUM":             "0xa89b5934863447f6e4fc53b315a93e873bda69a3",
-		"LUN":             "0xfa05a73ffe78ef8f1a739473e462c54bae6567d9",
-		"SET":             "0xe06eda7435ba749b047380ced49121dde93334ae",
-		"DGD":             "0xe0b7927c4af23765cb51314a0e0521a9645f0e2a",
-		"AST":             "0x27054b13b1b798b345b591a4d22e6562d47ea75a",
-		"ECN":             "0xa578acc0cb7875781b7880903f4594d13cfa8b98",
-		"onG":             "0xd341d1680eeee3255b8c4c75bcce7eb57f144dae",
-		"WPR":             "0x4cf488387f035ff08c371515562cba712f9015d4",
-		"DGX":             "0x55b9a11c2e8351b4ffc7b11561148bfac9977855",
-		"APPC":            "0x1a7a8bd9106f2b8d977e08582dc7d24c723ab0db",
-		"LNC":             "0x63e634330a20150dbb61b15648bc73855d6ccf07",
-		"WPC":             "0x62087245087125d3db5b9a3d713d78e7bbc31e54",
-		"IMC":             "0xe3831c5a982b279a198456d577cfb90424cb6340",
-		"GTC":             "0xb70835d7822ebb9426b56543e391846c107bd32c",
-		"Hdp":             "0xe9ff07809ccff05dae74990e25831d0bc5cbe575",
-		"IMT":             "0x22e5f62d0fa19974749faa194e3d3ef6d89c08d7",
-		"BLX (Bullion)":   "0xce59d29b09aae565feeef8e52f47c3cd5368c663",
-		"ART":             "0xfec0cf7fe078a500abf15f1284958f22049c2c7e",
-		"GOLDX":           "0xeab43193cf0623073ca89db9b712796356fa7414",
-		"CLP":             "0x7fce2856899a6806eeef70807985fc7554c66340",
-		"SHIT":            "0xef2e9966eb61bb494e5375d5df8d67b7db8a780d",
-		"OMG":             "0xd26114cd6ee289accf82350c8d8487fedb8a0c07",
-		"HVN":             "0xc0eb85285d83217cd7c891702bcbc0fc401e2d9d",
-		"KEE":             "0x72d32ac1c5e66bfc5b08806271f8eef915545164",
-		"ITC":             "0x5e6b6d9abad9093fdc861ea1600eba1b355cd940",
-		"KEY":             "0x4cc19356f2d37338b9802aa8e8fc58b0373296e7",
-		"ITT":             "0x0aef06dcccc531e581f0440059e6ffcc206039ee",
-		"ZST":             "0xe386b139ed3715ca4b18fd52671bdcea1cdfe4b1",
-		"ALCO":            "0x181a63746d3adcf356cbc73ace22832ffbb1ee5a",
-		"M-ETH":           "0x3f4b726668da46f5e0e75aa5d478acec9f38210f",
-		"SWM":             "0x9e88613418cf03dca54d6a2cf6ad934a78c7a17a",
-		"GTKT":            "0x025abad9e518516fdaafbdcdb9701b37fb7ef0fa",
-		"BTCE":            "0x0886949c1b8c412860c4264ceb8083d1365e86cf",
-		"CLN":             "0x4162178b78d6985480a308b2190ee5517460406d",
-		"BPT":             "0x327682779bab2bf4d1337e8974ab9de8275a7ca8",
-		"WBA":             "0x74951b677de32d596ee851a233336926e6a2cd09",
-		"CAT (BitClave)":  "0x1234567461d3f8db7496581774bd869c83d51c93",
-		"SWT":             "0xb9e7f8568e08d5659f5d29c4997173d84cdf2607",
-		"TRST":            "0xcb94be6f13a1182e4a4b6140cb7bf2025d28e41b",
-		"FRD":             "0x0abefb7611cb3a01ea3fad85f33c3c934f8e2cf4",
-		"FYN":             "0x88fcfbc22c6d3dbaa25af478c578978339bde77a",
-		"POS":             "0xee609fe292128cad03b786dbb9bc2634ccdbe7fc",
-		"EMONT":           "0x95daaab98046846bf4b2853e23cba236fa394a31",
-		"Unicorn":         "0x89205a3a3b2a69de6dbf7f01ed13b2108b2c43e7",
-		"POE":             "0x0e0989b1f9b8a38983c2ba8053269ca62ec9b195",
-		"LNC-Linker Coin": "0x6beb418fc6e1958204ac8baddcf109b8e9694966",
-		"SLT":             "0x7a5ff295dc8239d5c2374e4d894202aaf029cab6",
-		"MLN":             "0xbeb9ef514a379b997e0798fdcc901ee474b6d9a1",
-		"FKX":             "0x009e864923b49263c7f10d19b7f8ab7a9a5aad33",
-		"STORJ":           "0xb64ef51c888972c908cfacf59b47c1afbc0ab8ac",
-		"STORM":           "0xd0a4b8946cb52f0661273bfbc6fd0e0c75fc6433",
-		"SKIN":            "0x2bdc0d42996017fce214b21607a515da41a9e0c5",
-		"VERI":            "0x8f3470a7388c05ee4e7af3d01d8c722b0ff52374",
-		"DRGN":            "0x419c4db4b9e25d6db2ad9691ccb832c8d9fda05e",
-		"POOL":            "0x779b7b713c86e3e6774f5040d9ccc2d43ad375f8",
-		"MCO":             "0xb63b606ac810a52cca15e44bb630fd42d8d1d83d",
-		"XGM":             "0x533ef0984b2faa227acc620c67cce12aa39cd8cd",
-		"DICE":            "0x2e071d2966aa7d8decb1005885ba1977d6038a65",
-		"MCI":             "0x138a8752093f4f9a79aaedf48d4b9248fab93c9c",
-		"DATABroker":      "0x1b5f21ee98eed48d292e8e2d3ed82b40a9728a22",
-		"S-ETH":           "0x3eb91d237e491e0dee8582c402d85cb440fb6b54",
-		"QSP":             "0x99ea4db9ee77acd40b119bd1dc4e33e1c070b80d",
-		"LGR":             "0x2eb86e8fc520e0f6bb5d9af08f924fe70558ab89",
-		"XGT":             "0x30f4a3e0ab7a76733d8b60b89dd93c3d0b4c9e2f",
-		"SenSatorI":       "0x4ca74185532dc1789527194e5b9c866dd33f4e82",
-		"QAU":             "0x671abbe5ce652491985342e85428eb1b07bc6c64",
-		"PAY":             "0xb97048628db6b661d4c2aa833e95dbe1a905b280",
-		"UQC":             "0xd01db73e047855efb414e6202098c4be4cd2423b",
-		"SNIP":            "0x44f588aeeb8c44471439d1270b3603c66a9262f1",
-		"BCBC":            "0x7367a68039d4704f30bfbf6d948020c3b07dfc59",
-		"DCL":             "0x399a0e6fbeb3d74c85357439f4c8aed9678a5cbf",
-		"DCA":             "0x386faa4703a34a7fdb19bec2e14fd427c9638416",
-		"LIVE":            "0x24a77c1f17c547105e14813e517be06b0040aa76",
-		"HST":             "0x554c20b7c486beee439277b4540a434566dc4c02",
-		"ADI":             "0x8810c63470d38639954c6b41aac545848c46484a",
-		"C20":             "0x26e75307fc0c021472feb8f727839531f112f317",
-		"XID":             "0xb110ec7b1dcb8fab8dedbf28f53bc63ea5bedd84",
-		"KNC":             "0xdd974d5c2e2928dea5f71b9825b8b646686bd200",
-		"BNT":             "0x1f573d6fb3f13d689ff844b4ce37794d79a7ff1c",
-		"BNC":             "0xdd6bf56ca2ada24c683fac50e37783e55b57af9f",
-		"BNB":             "0xb8c77482e45f1f44de1745f52c74426c631bdd52",
-		"HAT":             "0x9002d4485b7594e3e850f0a206713b305113f69e",
-		"SYN":             "0x10b123fddde003243199aad03522065dc05827a0",
-		"COFI":            "0x3136ef851592acf49ca4c825131e364170fa32b3",
-		"SXUT":            "0x2c82c73d5b34aa015989462b2948cd616a37641f",
-		"KIN":             "0x818fc6c2ec5986bc6e2cbf00939d90556ab12ce5",
-		"ZAP":             "0x6781a0f84c7e9e846dcb84a9a5bd49333067b104",
-		"CRT":             "0xf0da1186a4977226b9135d0613ee72e229ec3f4d",
-		"SKR":             "0x4c382f8e09615ac86e08ce58266cc227e7d4d913",
-		"CRB":             "0xaef38fbfbf932d1aef3b808bc8fbd8cd8e1f8bc5",
-		"GBT":             "0x7585f835ae2d522722d2684323a0ba83401f32f5",
-		"EMV":             "0xb802b24e0637c2b87d2e8b7784c055bbe921011a",
-		"DMT":             "0x2ccbff3a042c68716ed2a2cb0c544a9f1d1935e1",
-		"PARETO":          "0xea5f88e54d982cbb0c441cde4e79bc305e5b43bc",
-		"ROUND":           "0x4993cb95c7443bdc06155c5f5688be9d8f6999a5",
-		"EAGLE":           "0x994f0dffdbae0bbf09b652d6f11a493fd33f42b9",
-		"PT":              "0x66497a283e0a007ba3974e837784c6ae323447de",
-		"SGEL":            "0xa1ccc166faf0e998b3e33225a1a0301b1c86119d",
-		"GEE":             "0x4f4f0db4de903b88f2b1a2847971e231d54f8fd3",
-		"MBRS":            "0x386467f1f3ddbe832448650418311a479eecfc57",
-		"VOISE":           "0x83eea00d838f92dec4d1475697b9f4d3537b56e3",
-		"SEXY":            "0x98f5e9b7f0e33956c0443e81bf7deb8b5b1ed545",
-		"DDF":             "0xcc4ef9eeaf656ac1a2ab886743e98e97e090ed38",
-		"SAN":             "0x7c5a0ce9267ed19b22f8cae653f198e3e8daf098",
-		"OPT":             "0x4355fc160f74328f9b383df2ec589bb3dfd82ba0",
-		"CR7":             "0x7f585b9130c64e9e9f470b618a7badd03d79ca7e",
-		"OHNI":            "0x7f2176ceb16dcb648dc924eff617c3dc2befd30d",
-		"ANT":             "0x960b236a07cf122663c4303350609a66a7b288c0",
-		"Devcon2 Token":   "0xdd94de9cfe063577051a5eb7465d08317d8808b6",
-		"STRC":            "0x46492473755e8df960f8034877f61732d718ce96",
-		"ALTS":            "0x638ac149ea8ef9a1286c41b977017aa7359e6cfa",
-		"FLIXX":           "0xf04a8ac553fcedb5ba99a64799155826c136b0be",
-		"MOD":             "0x957c30ab0426e0c93cd8241e2c60392d08c6ac8e",
-		"BCL":             "0xbc1234552ebea32b5121190356bba6d3bb225bb5",
-		"PET":             "0x5884969ec0480556e11d119980136a4c17edded1",
-		"COB":             "0xb2f7eb1f2c37645be61d73953035360e768d81e6",
-		"$TEAK":           "0x7dd7f56d697cc0f2b52bd55c057f378f1fe6ab4b",
-		"VRS":             "0xedbaf3c5100302dcdda53269322f3730b1f0416d",
-		"IKB":             "0x88ae96845e157558ef59e9ff90e766e22e480390",
-		"FLUZ":            "0x954b5de09a55e59755acbda29e1eb74a45d30175",
-		"eGAS":            "0xb53a96bcbdd9cf78dff20bab6c2be7baec8f00f8",
-		"cV":              "0xda6cb58a0d0c01610a29c5a65c303e13e885887c",
-		"NXX OLD":         "0x5c6183d10a00cd747a6dbb5f658ad514383e9419",
-		"CK":              "0x06012c8cf97bead5deae237070f9587f8e7a266d",
-		"NDC":             "0xa54ddc7b3cce7fc8b1e3fa0256d0db80d2c10970",
-		"SRN":             "0x68d57c9a1c35f63e2c83ee8e49a64e9d70528d25",
-		"BCPT":            "0x1c4481750daa5ff521a2a7490d9981ed46465dbd",
-		"WAX":             "0x39bb259f66e1c59d5abef88375979b4d20d98022",
-		"PLU":             "0xd8912c10681d8b21fd3742244f44658dba12264e",
-		"CO2":             "0xb4b1d2c217ec0776584ce08d3dd98f90ededa44b",
-		"NMR":             "0x1776e1f26f98b1a5df9cd347953a26dd3cb46671",
-		"PLR":             "0xe3818504c1b32bf1557b16c238b2e01fd3149c17",
-		"CDL":             "0x8a95ca448a52c0adf0054bb3402dc5e09cd6b232",
-		"MYD":             "0xf7e983781609012307f2514f63d526d83d24f466",
-		"CDT":             "0x177d39ac676ed1c67a2b268ad7f1e58826e5b0af",
-		"GELD":            "0x24083bb30072643c3bb90b44b7285860a755e687",
-		"ALIS":            "0xea610b1153477720748dc13ed378003941d84fab",
-		"CDX":             "0x6fff3806bbac52a20e0d79bc538d527f6a22c96b",
-		"LUCK":            "0xfb12e3cca983b9f59d90912fd17f8d745a8b2953",
-		"ARCT":            "0x1245ef80f4d9e02ed9425375e8f649b9221b31d8",
-		"LEMO":            "0xd6e354f07319e2474491d8c7c712137bee6862a2",
-		"AIX":             "0x1063ce524265d5a3a624f4914acd573dd89ce988",
-		"GNT":             "0xa74476443119a942de498590fe1f2454d7d4ac0d",
-		"RCT":             "0x2a3aa9eca41e720ed46b5a70d6c37efa47f768ac",
-		"LOK":             "0x21ae23b882a340a22282162086bc98d3e2b73018",
-		"PRSP":            "0x0c04d4f331da8df75f9e2e271e3f3f1494c66c36",
-		"AIR":             "0x27dce1ec4d3f72c3e457cc50354f1f975ddef488",
-		"MKR":             "0x9f8f72aa9304c8b593d555f12ef6589cc3a579a2",
-		"MNTP":            "0x83cee9e086a77e492ee0bb93c2b0437ad6fdeccc",
-		"ELIX":            "0xc8c6a31a4a806d3710a7b38b7b296d2fabccdba8",
-		"CVC":             "0x41e5560054824ea6b0732e656e3ad64e20e94e45",
-		"OLD_MKR":         "0xc66ea802717bfb9833400264dd12c2bceaa34a6d",
-		"BLX (Iconomi)":   "0xe5a7c12972f3bbfe70ed29521c8949b8af6a0970",
-		"VIBE":            "0xe8ff5c9c75deb346acac493c463c8950be03dfba",
-		"ABT":             "0xb98d4c97425d9908e66e53a6fdf673acca0be986",
-		"SOL":             "0x1f54638b7737193ffd86c19ec51907a7c41755d8",
-		"GAM":             "0xf67451dc8421f0e0afeb52faa8101034ed081ed9",
-		"PPP":             "0xc42209accc14029c1012fb5680d95fbd6036e2a0",
-		"XRL":             "0xb24754be79281553dc1adc160ddf5cd9b74361a4",
-		"PPT":             "0xd4fa1460f537bb9085d22c7bccb5dd450ef28e3a",
-		"TWNKL":           "0xfbd0d1c77b501796a35d86cf91d65d9778eee695",
-		"MTX":             "0x0af44e2784637218dd1d32a322d44e603a8f0c6a",
-		"MTR":             "0x7fc408011165760ee31be2bf20daf450356692af",
-		"UTK":             "0x70a72833d6bf7f508c8224ce59ea1ef3d0ea3a38",
-		"X8X":             "0x910dfc18d6ea3d6a7124a6f8b5458f281060fa4c",
-		"MTH":             "0xaf4dce16da2877f8c9e00544c93b62ac40631f16",
-		"MTN":             "0x41dbecc1cdc5517c6f76f6a6e836adbee2754de3",
-		"MTL":             "0xf433089366899d83a9f26a773d59ec7ecf30355e",
-		"DADI":            "0xfb2f26f266fb2805a387230f2aa0a331b4d96fba",
-		"ARX":             "0x7705faa34b16eb6d77dfc7812be2367ba6b0248e",
-		"ARC":             "0xac709fcb44a43c35f0da4e3163b117a17f3770f5",
-		"ARD":             "0x75aa7b0d02532f3833b66c7f0ad35376d373ddf8",
-		"NxC":             "0x45e42d659d9f9466cd5df622506033145a9b89bc",
-		"SPANK":           "0x42d6622dece394b54999fbd73d108123806f6a18",
-		"ARN":             "0xba5f11b16b155792cf3b2e6880e8706859a8aeb6",
-		"BCDN":            "0x1e797ce986c3cff4472f7d38d5c4aba55dfefe40",
-		"TIX":             "0xea1f346faf023f974eb5adaf088bbcdf02d761f4",
-		"BOP":             "0x7f1e2c7d6a69bf34824d72c53b4550e895c0d8c2",
-		"DRP":             "0x621d78f2ef2fd937bfca696cabaf9a779f59b3ed",
-		"BOU":             "0xc2c63f23ec5e97efbd7565df9ec764fdc7d4e91d",
-		"CCS":             "0x315ce59fafd3a8d562b7ec1c8542382d2710b06c",
-		"SGT":             "0xd248b0d48e44aaf9c49aea0312be7e13a6dc1468",
-		"TIO":             "0x80bc5512561c7f85a3a9508c7df7901b370fa1df",
-		"BON":             "0xcc34366e3842ca1bd36c1f324d15257960fcc801",
-		"OAX":             "0x701c244b988a513c945973defa05de933b23fe1d",
-		"RVL":             "0x41f615e24fabd2b097a320e9e6c1f448cb40521c",
-		"PIX":             "0x8effd494eb698cc399af6231fccd39e08fd20b15",
-		"QTQ":             "0x2c3c1f05187dba7a5f2dd47dca57281c4d4f183f",
-		"RVT":             "0x3d1ba9be9f66b8ee101911bc36d3fb562eac2244",
-		"HKG":             "0x14f37b574242d366558db61f3335289a5035c506",
-		"SKO1":            "0x4994e81897a920c0fea235eb8cedeed3c6fff697",
-		"SMT":             "0x2dcfaac11c9eebd8c6c42103fe9e2a6ad237af27",
-		"NXX":             "0x7627de4b93263a6a7570b8dafa64bae812e5c394",
-		"EXMR":            "0xc98e0639c6d2ec037a615341c369666b110e80e5",
-		"DUBI":            "0xd4cffeef10f60eca581b5e1146b5aca4194a4c3b",
-		"JetCoins":        "0x773450335ed4ec3db45af74f34f2c85348645d39",
-		"BQX":             "0x5af2be193a6abca9c8817001f45744777db30756",
-		"GXC":             "0x58ca3065c0f24c7c96aee8d6056b5b5decf9c2f8",
-		"CC3":             "0xc166038705ffbab3794185b3a9d925632a1df37d",
-		"DATACoin":        "0x0cf0ee63788a0849fe5297f3407f701e122cc023",
-		"CXC":             "0x2134057c0b461f898d375cead652acae62b59541",
-		"REN":             "0x408e41876cccdc0f92210600ef50372656052a38",
-		"CXO":             "0xb6ee9668771a79be7967ee29a63d4184f8097143",
-		"FLX":             "0x70b147e01e9285e7ce68b9ba437fe3a9190e756a",
-		"PEXT":            "0x55c2a0c171d920843560594de3d6eecc09efc098",
-		"FLP":             "0x3a1bda28adb5b0a812a7cf10a1950c920f79bcd3",
-		"IoT":             "0xc34b21f6f8e51cc965c2393b3ccfa3b82beb2403",
-		"XSC":             "0x0f513ffb4926ff82d7f60a05069047aca295c413",
-		"IPL":             "0x64cdf819d3e75ac8ec217b3496d7ce167be42e80",
-		"AE":              "0x5ca9a71b1d01849c0a95490cc00559717fcf0d1d",
-		"DEB":             "0x151202c9c18e495656f372281f493eb7698961d5",
-		"SCL":             "0xd7631787b4dcc87b1254cfd1e5ce48e96823dee8",
-		"CREDO":           "0x4e0603e2a27a30480e5e3a4fe548e29ef12f64be",
-		"ROK":             "0xc9de4b7f0c3d991e967158e4d4bfa4b51ec0b114",
-		"AMB":             "0x4dc3643dbc642b72c158e7f3d2ff232df61cb6ce",
-		"MNT":             "0xa9877b1e05d035899131dbd1e403825166d09f92",
-		"OST":             "0x2c4e8f2d746113d0696ce89b35f0d8bf88e0aeca",
-		"CATs (BitClave)_Old": "0x68e14bb5a45b9681327e16e528084b9d962c1a39",
-		"RLTY":                "0xbe99b09709fc753b09bcf557a992f6605d5997b0",
-		"TBC2":                "0xfaccd5fc83c3e4c3c1ac1ef35d15adf06bcf209c",
-		"AION":                "0x4ceda7906a5ed2179785cd3a40a69ee8bc99c466",
-		"MNE":                 "0x1a95b271b0535d15fa49932daba31ba612b52946",
-		"BDG":                 "0x1961b3331969ed52770751fc718ef530838b6dee",
-		"ADT":                 "0xd0d6d6c5fe4a677d343cc433536bb717bae167dd",
-		"300":                 "0xaec98a708810414878c3bcdf46aad31ded4a4557",
-		"RPL":                 "0xb4efd85c19999d84251304bda99e90b92300bd93",
-		"TRC":                 "0xcb3f902bf97626391bf8ba87264bbc3dc13469be",
-		"VIU":                 "0x519475b31653e46d20cd09f9fdcf3b12bdacb4f5",
-		"ESZ":                 "0xe8a1df958be379045e2b46a31a98b93a2ecdfded",
-		"USDT":                "0xdac17f958d2ee523a2206206994597c13d831ec7",
-		"TRX":                 "0xf230b790e05390fc8295f4d3f60332c93bed42e2",
-		"SKRP":                "0x6e34d8d84764d40f6d7b39cd569fd017bf53177d",
-		"PBL":                 "0x55648de19836338549130b1af587f16bea46f66b",
-		"LRC":                 "0xef68e7c694f40c8202821edf525de3782458639f",
-		"AVT":                 "0x0d88ed6e74bbfd96b831231638b66c05571e824f",
-		"MYST":                "0xa645264c5603e96c3b0b078cdab68733794b0a71",
-		"BST":                 "0x509a38b7a1cc0dcd83aa9d06214663d9ec7c7f4a",
-		"SENSE":               "0x6745fab6801e376cd24f03572b9c9b0d4edddccf",
-		"AVA":                 "0xed247980396b10169bb1d36f6e278ed16700a60f",
-		"ACC":                 "0x13f1b7fdfbe1fc66676d56483e21b1ecb40b58e2",
-		"WHO":                 "0xe933c0cd9784414d5f278c114904f5a84b396919",
-		"SUB":                 "0x12480e24eb5bec1a9d4369cab6a80cad3c0a377a",
-		"IXT":                 "0xfca47962d45adfdfd1ab2d972315db4ce7ccf094",
-		"NET":                 "0xcfb98637bcae43c13323eaa1731ced2b716962fd",
-		"NEU":                 "0xa823e6722006afe99e91c30ff5295052fe6b8e32",
-		"XNN":                 "0xab95e915c123fded5bdfb6325e35ef5515f1ea69",
-		"TEL":                 "0x85e076361cc813a908ff672f9bad1541474402b2",
-		"BKB":                 "0xb2bfeb70b903f1baac7f2ba2c62934c7e5b974c4",
-		"STP":                 "0xecd570bbf74761b960fa04cc10fe2c4e86ffda36",
-		"Ox Fina":             "0x65a15014964f2102ff58647e16a16a6b9e14bcf6",
-		"PIPL":                "0xe64509f0bf07ce2d29a7ef19a8a9bc065477c1b4",
-		"SALT":                "0x4156d3342d5c385a87d264f90653733592000581",
-		"ICOS":                "0x014b50466590340d41307cc54dcee990c8d58aa8",
-		"ELF":                 "0xbf2179859fc6d5bee9bf9158632dc51678a4100e",
-		"ADX":                 "0x4470bb87d77b963a013db939be332f927f2b992e",
-		"DNT":                 "0x0abdace70d3790235af448c88547603b945604ea",
-		"RDN":                 "0x255aa6df07540cb5d3d297f0d0d4d84cb52bc8e6",
-		"DNX":                 "0xe43e2041dc3786e166961ed9484a5539033d10fb",
-		"GMT":                 "0xb3bd49e28f8f832b8d1e246106991e546c323502",
-		"WIC":                 "0x62cd07d414ec50b68c7ecaa863a23d344f2d062f",
-		"WaBi":                "0x286bda1413a2df81731d4930ce2f862a35a609fe",
-		"KZN":                 "0x9541fd8b9b5fa97381783783cebf2f5fa793c262",
-		"WINGS":               "0x667088b212ce3d06a1b553a7221e1fd19000d9af",
-		"DGPT":                "0xf6cfe53d6febaeea051f400ff5fc14f0cbbdaca1",
-		"NULS":                "0xb91318f35bdb262e9423bc7c7c2a3a93dd93c92c",
-		"DALC":                "0x07d9e49ea402194bf48a8276dafb16e4ed633317",
-		"NEWB":                "0x814964b1bceaf24e26296d031eadf134a2ca4105",
-		"TWN":                 "0x2ef1ab8a26187c58bb8aaeb11b2fc6d25c5c0716",
-		"MSP":                 "0x68aa3f232da9bdc2343465545794ef3eea5209bd",
-		"CRPT":                "0x80a7e048f37a50500351c204cb407766fa3bae7f",
-		"DAB":                 "0xdab0c31bf34c897fb0fe90d12ec9401caf5c36ec",
-		"DAN":                 "0x9b70740e708a083c6ff38df52297020f5dfaa5ee",
-		"DAO":                 "0xbb9bc244d798123fde783fcc1c72d3bb8c189413",
-		"DAI":                 "0x89d24a6b4ccb1b6faa2625fe562bdd9a23260359",
-		"PXT":                 "0xc14830e53aa344e8c14603a91229a0b925b0b262",
-		"DAT":                 "0x81c9151de0c8bafcd325a57e3db5a5df1cebf79c",
-		"BCDT":                "0xacfa209fb73bf3dd5bbfb1101b9bc999c49062a5",
-		"BNTY":                "0xd2d6158683aee4cc838067727209a0aaf4359de3",
-		"TNT":                 "0x08f5a9235b08173b7569f83645d2c7fb55e8ccd8",
-		"PLAY":                "0xe477292f1b3268687a29376116b0ed27a9c76170",
-		"ICO":                 "0xa33e729bf4fdeb868b534e1f20523463d9c46bee",
-		"ICN":                 "0x888666ca69e0f178ded6d75b5726cee99a87d698",
-		"ETHB":                "0x3a26746ddb79b1b8e4450e3f4ffe3285a307387e",
-		"ICE":                 "0x5a84969bb663fb64f6d015dcf9f622aedc796750",
-		"FUEL":                "0xea38eaa3c86c8f9b751533ba2e562deb9acded40",
-		"CND":                 "0xd4c435f5b09f855c3317c8524cb1f586e42795fa",
-		"ICX":                 "0xb5a5f22694352c15b00323844ad545abb2b11028",
-		"GVT":                 "0x103c3a209da59d3e7c4a89307e66521e081cfdf0",
-		"BRAT":                "0x9e77d5a1251b6f7d456722a6eac6d2d5980bd891",
-		"SNOV":                "0xbdc5bac39dbe132b1e030e898ae3830017d7d969",
-		"SPARTA":              "0x24aef3bf1a47561500f9430d74ed4097c47f51f2",
-		"VEE":                 "0x340d2bde5eb28c1eed91b2f790723e3b160613b7",
-		"VSL":                 "0x5c543e7ae0a1104f78406c340e9c64fd9fce5170",
-	}
-)
+// A2D maps token addresses to their number of decimals, for the tokens
+// which don't have 18.
+var A2D = map[string]int{
+	"0x05f4a42e251f2d52b8ed15e9fedaacfcef1fad27": 12,
+	"0x08711d3b02c8758f2fb3ab4e80228418a7f8e39c": 0,
+	"0x08f5a9235b08173b7569f83645d2c7fb55e8ccd8": 8,
+	"0x0e0989b1f9b8a38983c2ba8053269ca62ec9b195": 8,
+	"0x12480e24eb5bec1a9d4369cab6a80cad3c0a377a": 2,
+	"0x27054b13b1b798b345b591a4d22e6562d47ea75a": 4,
+	"0x27695e09149adc738a978e9a678f99e4c39e9eb9": 8,
+	"0x3597bfd533a99c9aa083587b074434e61eb0a258": 8,
+	"0x39bb259f66e1c59d5abef88375979b4d20d98022": 8,
+	"0x4156d3342d5c385a87d264f90653733592000581": 8,
+	"0x419d0d8bdd9af5e606ae2232ed285aff190e711b": 8,
+	"0x41e5560054824ea6b0732e656e3ad64e20e94e45": 8,
+	"0x4470bb87d77b963a013db939be332f927f2b992e": 4,
+	"0x4ceda7906a5ed2179785cd3a40a69ee8bc99c466": 8,
+	"0x4df812f6064def1e5e029f1ca858777cc98d2d81": 8,
+	"0x55b9a11c2e8351b4ffc7b11561148bfac9977855": 9,
+	"0x595832f8fc6bf59c85c527fec3740a1b7a361269": 6,
+	"0x5af2be193a6abca9c8817001f45744777db30756": 8,
+	"0x607f4c5bb672230e8672085532f7e901544a7375": 9,
+	"0x6531f133e6deebe7f2dce5a0441aa7ef330b4e53": 8,
+	"0xaaaf91d9b90df800df4f55c205fd6989c977e73a": 8,
+	"0xaec2e87e0a235266d9c5adc9deb4b2e29b54d009": 0,
+	"0xaf4dce16da2877f8c9e00544c93b62ac40631f16": 5,
+	"0xb63b606ac810a52cca15e44bb630fd42d8d1d83d": 8,
+	"0xb64ef51c888972c908cfacf59b47c1afbc0ab8ac": 8,
+	"0xba5f11b16b155792cf3b2e6880e8706859a8aeb6": 8,
+	"0xcb97e65f07da24d46bcdd078ebebd7c6e6e3d750": 8,
+	"0xd4fa1460f537bb9085d22c7bccb5dd450ef28e3a": 8,
+	"0xdac17f958d2ee523a2206206994597c13d831ec7": 6,
+	"0xe0b7927c4af23765cb51314a0e0521a9645f0e2a": 9,
+	"0xf230b790e05390fc8295f4d3f60332c93bed42e2": 6,
+	"0xf3db5fa2c66b7af3eb0c0b782510816cbe4813b8": 4,
+	"0xf433089366899d83a9f26a773d59ec7ecf30355e": 8,
+	"0xf7b098298f7c69fc14610bf71d5e02c60792894c": 3,
+}
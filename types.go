@@ -6,8 +6,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
-	"math"
 	"math/big"
 	"net/http"
 	"reflect"
@@ -21,8 +19,6 @@ import (
 	"github.com/ethereum/go-ethereum/crypto/sha3"
 )
 
-type Address [20]byte
-
 type Uint256 [32]byte
 
 func HexStringToBytes(hexString string) ([]byte, error) {
@@ -39,16 +35,6 @@ func IntStringToBytes(intString string) ([]byte, error) {
 
 }
 
-func (addr *Address) Scan(src interface{}) error {
-	switch v := src.(type) {
-	case []byte:
-		copy(addr[:], v)
-		return nil
-	default:
-		return errors.New("Address scanner src should be []byte")
-	}
-}
-
 func (addr *Uint256) Scan(src interface{}) error {
 	switch v := src.(type) {
 	case []byte:
@@ -73,20 +59,23 @@ func (u *Uint256) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-/*
-func (a *Address) MarshalJSON() ([]byte, error) {
-	return nil, nil
-
+func (u Uint256) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.Big().String())
 }
-func (a *Address) UnmarshalJSON(b []byte) error {
-	bs, err := HexStringToBytes(strings.Trim(string(b), `"`))
-	if err != nil {
-		return err
+
+// NewUint256 converts a non-negative big.Int of at most 256 bits.
+func NewUint256(i *big.Int) (*Uint256, error) {
+	if i.Sign() < 0 || i.BitLen() > 256 {
+		return nil, fmt.Errorf("%s out of uint256 range", i)
 	}
-	copy(a[:], bs)
-	return nil
+	u := &Uint256{}
+	copy(u[:], abi.U256(i))
+	return u, nil
+}
+
+func (u *Uint256) Big() *big.Int {
+	return new(big.Int).SetBytes(u[:])
 }
-*/
 
 type jsonToken struct {
 	Address   string `json:"address"`
@@ -102,26 +91,57 @@ type Token struct {
 	Precision uint64
 }
 
+// ListOpts specifies the page of results to fetch. The relayer returns
+// the first page of defaultPerPage items if not set.
+type ListOpts struct {
+	Page    int `url:"page,omitempty"`
+	PerPage int `url:"per_page,omitempty"`
+}
+
+// PairsOpts filters the token pairs. Zero addresses are left out of the
+// query.
 type PairsOpts struct {
-	TokenA string `url:"tokenA,omitempty"`
-	TokenB string `url:"tokenB,omitempty"`
+	TokenA Address `url:"tokenA,omitempty"`
+	TokenB Address `url:"tokenB,omitempty"`
+	ListOpts
 }
 
+// OrdersOpts filters the orders. Zero addresses are left out of the query.
 type OrdersOpts struct {
-	ExchangeAddress string `url:"exchangeContractAddress,omitempty"`
-	TokenAddress    string `url:"tokenAddress,omitempty"`
-	MakerToken      string `url:"makerTokenAddress,omitempty"`
-	TakerToken      string `url:"takerTokenAddress,omitempty"`
+	ExchangeAddress Address `url:"exchangeContractAddress,omitempty"`
+	TokenAddress    Address `url:"tokenAddress,omitempty"`
+	MakerToken      Address `url:"makerTokenAddress,omitempty"`
+	TakerToken      Address `url:"takerTokenAddress,omitempty"`
 	PairsOpts
-	Maker        string `url:"maker,omitempty"`
-	Taker        string `url:"taker,omitempty"`
-	Trader       string `url:"trader,omitempty"`
-	FeeRecipient string `url:"feeRecipient,omitempty"`
+	Maker        Address `url:"maker,omitempty"`
+	Taker        Address `url:"taker,omitempty"`
+	Trader       Address `url:"trader,omitempty"`
+	FeeRecipient Address `url:"feeRecipient,omitempty"`
+}
+
+// FeesRequest describes an order the relayer should quote fees for.
+type FeesRequest struct {
+	ExchangeAddress          Address `json:"exchangeContractAddress"`
+	Maker                    Address `json:"maker"`
+	Taker                    Address `json:"taker"`
+	MakerToken               Address `json:"makerTokenAddress"`
+	TakerToken               Address `json:"takerTokenAddress"`
+	MakerTokenAmount         Uint256 `json:"makerTokenAmount"`
+	TakerTokenAmount         Uint256 `json:"takerTokenAmount"`
+	ExpirationTimestampInSec Uint256 `json:"expirationUnixTimestampSec"`
+	Salt                     Uint256 `json:"salt"`
+}
+
+// FeesResponse holds the fee recipient and fees the relayer requires.
+type FeesResponse struct {
+	FeeRecipient Address `json:"feeRecipient"`
+	MakerFee     Uint256 `json:"makerFee"`
+	TakerFee     Uint256 `json:"takerFee"`
 }
 
 type OrderbookOpts struct {
-	BaseTokenAddress  string `url:"baseTokenAddress"`
-	QuoteTokenAddress string `url:"quoteTokenAddress"`
+	BaseTokenAddress  Address `url:"baseTokenAddress"`
+	QuoteTokenAddress Address `url:"quoteTokenAddress"`
 }
 
 type Orderbook struct {
@@ -335,6 +355,37 @@ func (order *Order) Hash() []byte {
 	return sha.Sum(nil)
 }
 
+// FeesRequest builds a fee quote request from the order's fields.
+func (order *Order) FeesRequest() *FeesRequest {
+	return &FeesRequest{
+		ExchangeAddress:          *order.ExchangeAddress,
+		Maker:                    *order.Maker,
+		Taker:                    *order.Taker,
+		MakerToken:               *order.MakerToken,
+		TakerToken:               *order.TakerToken,
+		MakerTokenAmount:         *order.MakerTokenAmount,
+		TakerTokenAmount:         *order.TakerTokenAmount,
+		ExpirationTimestampInSec: *order.ExpirationTimestampInSec,
+		Salt:                     *order.Salt,
+	}
+}
+
+// ApplyFees sets the fee recipient and fees quoted by the relayer. The
+// order hash changes, so the signature is cleared and the order has to be
+// signed afterwards.
+func (order *Order) ApplyFees(f *FeesResponse) {
+	*order.FeeRecipient = f.FeeRecipient
+	*order.MakerFee = f.MakerFee
+	*order.TakerFee = f.TakerFee
+	order.Signature = &Signature{}
+}
+
+// HashHex returns the order hash as a 0x-prefixed hex string, the form
+// used by the relayer to identify orders.
+func (order *Order) HashHex() string {
+	return fmt.Sprintf("%#x", order.Hash())
+}
+
 type APIOrder struct {
 	Maker                     string       `json:"maker"`
 	Taker                     string       `json:"taker"`
@@ -351,9 +402,41 @@ type APIOrder struct {
 	Signature                 APISignature `json:"ecSignature"`
 	TakerTokenAmountFilled    string       `json:"-"`
 	TakerTokenAmountCancelled string       `json:"-"`
-	Price                     float64
-	Volume                    *big.Int
-	Pair                      string
+	Price                     float64      `json:"-"`
+	Volume                    *big.Int     `json:"-"`
+	Pair                      string       `json:"-"`
+}
+
+// ToOrder parses the string fields of a into an Order with Signature.Hash
+// filled. Missing filled/cancelled amounts are taken as zero.
+func (a *APIOrder) ToOrder() (*Order, error) {
+	filled := a.TakerTokenAmountFilled
+	if filled == "" {
+		filled = "0"
+	}
+	cancelled := a.TakerTokenAmountCancelled
+	if cancelled == "" {
+		cancelled = "0"
+	}
+	return NewOrder(
+		a.Maker,
+		a.Taker,
+		a.MakerToken,
+		a.TakerToken,
+		a.FeeRecipient,
+		a.ExchangeAddress,
+		a.MakerTokenAmount,
+		a.TakerTokenAmount,
+		a.MakerFee,
+		a.TakerFee,
+		a.ExpirationTimestampInSec,
+		a.Salt,
+		string(a.Signature.V),
+		a.Signature.R,
+		a.Signature.S,
+		filled,
+		cancelled,
+	)
 }
 
 func (order *Order) UnmarshalJSON(b []byte) error {
@@ -437,69 +520,92 @@ func (order *Order) Bytes() [441]byte {
 
 type BookOrder struct {
 	// Bid/Ask
-	Type       string
-	Pair       string
-	Price      float64
-	Volume     float64
-	MakerToken string
-	TakerToken string
+	Type string
+	Pair string
+	// Price is the exact price in units of the quote token per base
+	// token.
+	Price *big.Rat
+	// Volume is the amount of the base token in its base units.
+	Volume         *big.Int
+	VolumeDecimals int
+	MakerToken     string
+	TakerToken     string
+	// Hash of the order, set by LocalBook
+	Hash string
 }
 
 func (o *BookOrder) String() string {
-	return fmt.Sprintf("%s\t%f\t%f", o.Pair, o.Price, o.Volume)
+	return fmt.Sprintf("%s\t%s\t%s", o.Pair, o.PriceString(6), o.VolumeString())
 }
 
-func strToFloat(s string, o int) (float64, error) {
-	fmtStr := fmt.Sprintf("%%0%ds", o)
-	s = fmt.Sprintf(fmtStr, s)
-	l := len(s)
-	a := s[0:l-o] + "." + s[l-o:]
-	f, err := strconv.ParseFloat(a, 64)
-	if err != nil {
-		return 0.0, err
-	}
-	return f, nil
+// PriceFloat returns the price as the nearest float64, for convenience.
+func (o *BookOrder) PriceFloat() float64 {
+	f, _ := o.Price.Float64()
+	return f
+}
+
+// VolumeFloat returns the volume in whole tokens as the nearest float64,
+// for convenience.
+func (o *BookOrder) VolumeFloat() float64 {
+	f, _ := new(big.Rat).SetFrac(o.Volume, pow10(o.VolumeDecimals)).Float64()
+	return f
+}
+
+// PriceString formats the price with prec decimals, rounded.
+func (o *BookOrder) PriceString(prec int) string {
+	return o.Price.FloatString(prec)
+}
+
+// VolumeString formats the volume in whole tokens, exactly.
+func (o *BookOrder) VolumeString() string {
+	return FormatAmount(o.Volume, o.VolumeDecimals)
 }
 
+// Process converts the order into a BookOrder of the given side, naming
+// and scaling its tokens with DefaultTokens.
 func (a *APIOrder) Process(bidask string) (*BookOrder, error) {
+	return a.ProcessWith(DefaultTokens, bidask)
+}
+
+// ProcessWith is Process looking the tokens up in r, for tokens of other
+// networks or missing from DefaultTokens.
+func (a *APIOrder) ProcessWith(r *TokenRegistry, bidask string) (*BookOrder, error) {
 	if bidask != "Bid" && bidask != "Ask" {
 		return nil, fmt.Errorf("%s is invalid, must be Bid or Ask", bidask)
 	}
 	bo := BookOrder{
 		Type:       bidask,
-		MakerToken: A2T[a.MakerToken],
-		TakerToken: A2T[a.TakerToken],
+		MakerToken: r.Symbol(a.MakerToken),
+		TakerToken: r.Symbol(a.TakerToken),
 	}
-	amountMaker := new(big.Int)
-	_, err := fmt.Sscan(a.MakerTokenAmount, amountMaker)
-	if err != nil {
-		return nil, err
+	amountMaker, ok := new(big.Int).SetString(a.MakerTokenAmount, 10)
+	if !ok || amountMaker.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid makerTokenAmount %s", a.MakerTokenAmount)
 	}
-	amountTaker := new(big.Int)
-	_, err = fmt.Sscan(a.TakerTokenAmount, amountTaker)
-	if err != nil {
-		return nil, err
+	amountTaker, ok := new(big.Int).SetString(a.TakerTokenAmount, 10)
+	if !ok || amountTaker.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid takerTokenAmount %s", a.TakerTokenAmount)
 	}
+	// base and quote amounts, and their decimals
 	numer, denom := amountMaker, amountTaker
+	baseDec, quoteDec := r.Decimals(a.TakerToken), r.Decimals(a.MakerToken)
 	bo.Pair = fmt.Sprintf("%s/%s", bo.TakerToken, bo.MakerToken)
-	volStr := a.TakerTokenAmount
+	bo.Volume = amountTaker
 	if bidask == "Ask" {
 		numer, denom = amountTaker, amountMaker
+		baseDec, quoteDec = quoteDec, baseDec
 		bo.Pair = fmt.Sprintf("%s/%s", bo.MakerToken, bo.TakerToken)
-		volStr = a.MakerTokenAmount
+		bo.Volume = amountMaker
 	}
 
-	bigPrice := new(big.Rat).SetFrac(numer, denom)
-	f, _ := bigPrice.Float64()
-	if math.IsInf(f, 1.) {
-		return nil, errors.New("the unit price is too high for float64")
+	// price of whole tokens, scaled from the base units
+	bo.Price = new(big.Rat).SetFrac(numer, denom)
+	if d := baseDec - quoteDec; d > 0 {
+		bo.Price.Mul(bo.Price, new(big.Rat).SetInt(pow10(d)))
+	} else if d < 0 {
+		bo.Price.Quo(bo.Price, new(big.Rat).SetInt(pow10(-d)))
 	}
-	bo.Price = f
-	bo.Volume, err = strToFloat(volStr, 18)
-	if err != nil {
-		return nil, err
-	}
-
+	bo.VolumeDecimals = baseDec
 	return &bo, nil
 
 }
@@ -551,19 +657,23 @@ type APISignature struct {
 	Hash string      `json:"-"`
 }
 
+// Verify reports whether the signature of Hash was made by address.
 func (sig *Signature) Verify(address *Address) bool {
+	ok, _ := sig.verify(address)
+	return ok
+}
+
+// verify is Verify returning why the signer can't be recovered.
+func (sig *Signature) verify(address *Address) (bool, error) {
 	sigValue, _ := sig.Value()
 	sigBytes := sigValue.([]byte)
 
-	hashedBytes := append([]byte("\x19Ethereum Signed Message:\n32"), sig.Hash[:]...)
-	signedBytes := crypto.Keccak256(hashedBytes)
-	pub, err := crypto.Ecrecover(signedBytes, sigBytes)
+	pub, err := crypto.Ecrecover(personalMessageHash(sig.Hash[:]), sigBytes)
 	if err != nil {
-		log.Println(err.Error())
-		return false
+		return false, err
 	}
 	recoverAddress := common.BytesToAddress(crypto.Keccak256(pub[1:])[12:])
-	return reflect.DeepEqual(address[:], recoverAddress[:])
+	return reflect.DeepEqual(address[:], recoverAddress[:]), nil
 }
 
 func (sig *Signature) Value() (driver.Value, error) {
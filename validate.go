@@ -0,0 +1,93 @@
+package rrgo
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Ethereum networks with a known 0x exchange contract.
+const (
+	NetworkMainnet = 1
+	NetworkKovan   = 42
+)
+
+// ExchangeAddresses maps network IDs to the 0x exchange contract address.
+var ExchangeAddresses = map[int]Address{
+	NetworkMainnet: MustHexToAddress("0x12459c951127e0c374ff9105dda097662a027093"),
+	NetworkKovan:   MustHexToAddress("0x90fe2af704b34e0224bf2299c838e04d4dcf1364"),
+}
+
+// ValidateOpts configures Order.Validate.
+type ValidateOpts struct {
+	// NetworkID selects the expected exchange contract, mainnet if unset.
+	NetworkID int
+	// Taker is the address which is going to fill the order. Orders
+	// reserved for other takers are invalid. If nil, only orders open to
+	// anyone are valid.
+	Taker *Address
+	// Now is the clock to check expiration against, time.Now if unset.
+	Now func() time.Time
+}
+
+// Validate checks the order before it's trusted. It returns the violations
+// found, using the relayer's field names and validation error codes, or
+// nil if the order is valid.
+func (order *Order) Validate(vo ValidateOpts) []ValidationError {
+	ves := []ValidationError{}
+	add := func(field string, code int, reason string) {
+		ves = append(ves, ValidationError{Field: field, Code: code, Reason: reason})
+	}
+
+	if vo.NetworkID == 0 {
+		vo.NetworkID = NetworkMainnet
+	}
+	if vo.Now == nil {
+		vo.Now = time.Now
+	}
+
+	exchange, ok := ExchangeAddresses[vo.NetworkID]
+	if !ok {
+		add("exchangeContractAddress", CodeAddressNotSupported,
+			fmt.Sprintf("no exchange known for network %d", vo.NetworkID))
+	} else if *order.ExchangeAddress != exchange {
+		add("exchangeContractAddress", CodeAddressNotSupported,
+			fmt.Sprintf("not the exchange of network %d", vo.NetworkID))
+	}
+
+	zeroAddr := Address{}
+	if *order.Taker != zeroAddr && (vo.Taker == nil || *order.Taker != *vo.Taker) {
+		add("taker", CodeAddressNotSupported, "order is reserved for another taker")
+	}
+
+	if order.MakerTokenAmount.Big().Sign() == 0 {
+		add("makerTokenAmount", CodeValueOutOfRange, "must be greater than zero")
+	}
+	if order.TakerTokenAmount.Big().Sign() == 0 {
+		add("takerTokenAmount", CodeValueOutOfRange, "must be greater than zero")
+	}
+
+	now := big.NewInt(vo.Now().Unix())
+	if order.ExpirationTimestampInSec.Big().Cmp(now) <= 0 {
+		add("expirationUnixTimestampSec", CodeValueOutOfRange, "order has expired")
+	}
+
+	switch {
+	case order.Signature == nil:
+		add("ecSignature", CodeRequiredField, "missing signature")
+	case !bytes.Equal(order.Signature.Hash[:], order.Hash()):
+		add("ecSignature", CodeInvalidSignature, "signature is not over the order hash")
+	default:
+		if ok, err := order.Signature.verify(order.Maker); err != nil {
+			add("ecSignature", CodeInvalidSignature, "can't recover the signer: "+err.Error())
+		} else if !ok {
+			add("ecSignature", CodeInvalidSignature, "not signed by the maker")
+		}
+	}
+
+	if len(ves) == 0 {
+		return nil
+	}
+	return ves
+}
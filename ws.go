@@ -1,111 +1,302 @@
 package rrgo
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
-	"math/rand"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/buger/jsonparser"
 	"github.com/gorilla/websocket"
 )
 
-var (
-	websocketErrs = []int{
-		websocket.CloseNormalClosure,
-		websocket.CloseGoingAway,
-		websocket.CloseProtocolError,
-		websocket.CloseUnsupportedData,
-		websocket.CloseNoStatusReceived,
-		websocket.CloseAbnormalClosure,
-		websocket.CloseInvalidFramePayloadData,
-		websocket.ClosePolicyViolation,
-		websocket.CloseMessageTooBig,
-		websocket.CloseMandatoryExtension,
-		websocket.CloseInternalServerErr,
-		websocket.CloseServiceRestart,
-		websocket.CloseTryAgainLater,
-		websocket.CloseTLSHandshake,
-	}
-)
-
 const (
 	WSURL         = "wss://ws.radarrelay.com/0x/v0/ws"
 	snapshotLimit = 20
+	eventsBuffer  = 64
+
+	wsEndpointEnvVar        = "RRGO_WS_URL"
+	defaultHandshakeTimeout = 5 * time.Second
+	// closeTimeout is how long Close waits for the server to confirm
+	// the close frame
+	closeTimeout = time.Second
 )
 
+var errClientClosed = errors.New("websocket client is closed")
+
+// WSClient multiplexes orderbook subscriptions of many token pairs over a
+// single websocket connection. Messages are routed to the subscription by
+// their requestId.
+type WSClient struct {
+	// Reconnect is the policy Run follows when the connection breaks.
+	Reconnect ReconnectPolicy
+	// Heartbeat configures pings and stale feed detection.
+	Heartbeat Heartbeat
+	// Recorder, if set, saves the frames of the session for Replay.
+	Recorder *Recorder
+
+	url    string
+	dialer websocket.Dialer
+	header http.Header
+	tokens *TokenRegistry
+	events chan Event
+
+	// mu guards conn, which is replaced on reconnect, and the
+	// subscriptions
+	mu     sync.Mutex
+	conn   *websocket.Conn
+	nextID int
+	subs   map[int]*WSOrderbook
+	// wmu serializes writes, the connection supports one writer only
+	wmu sync.Mutex
+
+	closing   chan struct{}
+	closeOnce sync.Once
+	stopped   chan struct{}
+
+	stats wsStats
+}
+
+// WSOrderbook is a subscription to the orderbook channel of one token
+// pair, maintaining a local book from the snapshot and updates.
 type WSOrderbook struct {
-	WS                 *websocket.Conn
 	BaseTokenAddress   string
 	QuoteTokenAddress  string
 	Pair               string
 	SubscribeRequestID int
+	// limit is the snapshot limit of the subscription, replayed on
+	// reconnect
+	limit int
+	// lastMessage is guarded by client.mu
+	lastMessage time.Time
+	book        *LocalBook
+	client      *WSClient
 }
 
-func openWebsocket() (*websocket.Conn, error) {
-	dialer := websocket.Dialer{
-		HandshakeTimeout: time.Second * 5,
-	}
-	c, _, err := dialer.Dial(WSURL, nil)
+// openWebsocket connects to the configured endpoint.
+func (c *WSClient) openWebsocket() (*websocket.Conn, error) {
+	ws, _, err := c.dialer.Dial(c.url, c.header)
 	if err != nil {
 		return nil, err
 	}
-	return c, nil
+	return ws, nil
+}
 
+func newWSClient(url string) *WSClient {
+	return &WSClient{
+		Reconnect: DefaultReconnectPolicy,
+		Heartbeat: DefaultHeartbeat,
+		url:       url,
+		tokens:    DefaultTokens,
+		dialer: websocket.Dialer{
+			Proxy:            http.ProxyFromEnvironment,
+			HandshakeTimeout: defaultHandshakeTimeout,
+		},
+		events:  make(chan Event, eventsBuffer),
+		subs:    map[int]*WSOrderbook{},
+		closing: make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
 }
 
-func (wso *WSOrderbook) Subscribe(limit int) error {
-	ws, err := openWebsocket()
+// NewWSClient returns a client for the relayer websocket at WSURL, or at
+// RRGO_WS_URL if set. The connection is opened by the first Subscribe.
+func NewWSClient(opts ...WSOption) *WSClient {
+	u := os.Getenv(wsEndpointEnvVar)
+	if u == "" {
+		u = WSURL
+	}
+	c := newWSClient(u)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// NewWSOrderbook opens a websocket for a single pair. Run, Events and
+// Close of the returned WSOrderbook act on its own WSClient.
+func NewWSOrderbook(baseTA, quoteTA string, limit int, opts ...WSOption) (*WSOrderbook, error) {
+	return NewWSClient(opts...).Subscribe(baseTA, quoteTA, limit)
+}
+
+func (c *WSClient) ws() *websocket.Conn {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn
+}
+
+func (c *WSClient) closeWS() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn != nil {
+		c.conn.Close()
+	}
+}
+
+// dial opens the connection if there is none. The handshake runs without
+// c.mu, so that closing the client and the subscription methods don't
+// wait for it, and is abandoned when ctx is done or the client closed.
+func (c *WSClient) dial(ctx context.Context) (*websocket.Conn, error) {
+	if ws := c.ws(); ws != nil {
+		return ws, nil
+	}
+	type dialed struct {
+		ws  *websocket.Conn
+		err error
+	}
+	ch := make(chan dialed, 1)
+	go func() {
+		ws, err := c.openWebsocket()
+		ch <- dialed{ws, err}
+	}()
+	abandon := func() {
+		go func() {
+			if d := <-ch; d.ws != nil {
+				d.ws.Close()
+			}
+		}()
+	}
+	var ws *websocket.Conn
+	select {
+	case d := <-ch:
+		if d.err != nil {
+			return nil, d.err
+		}
+		ws = d.ws
+	case <-ctx.Done():
+		abandon()
+		return nil, ctx.Err()
+	case <-c.closing:
+		abandon()
+		return nil, errClientClosed
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch {
+	case c.conn != nil:
+		// a concurrent dial won
+		ws.Close()
+		return c.conn, nil
+	case ctx.Err() != nil:
+		// closeWS ran during the handshake
+		ws.Close()
+		return nil, ctx.Err()
+	case c.isClosing():
+		ws.Close()
+		return nil, errClientClosed
+	}
+	c.initConn(ws)
+	c.conn = ws
+	return ws, nil
+}
+
+func (c *WSClient) writeJSON(ws *websocket.Conn, v interface{}) error {
+	bs, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
-	rand.Seed(time.Now().UnixNano())
-	rID := rand.Int() % 5096
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	if err := ws.WriteMessage(websocket.TextMessage, bs); err != nil {
+		return err
+	}
+	c.Recorder.record(true, bs)
+	return nil
+}
 
-	sm := SubscribeMessage{
+func (wso *WSOrderbook) subscribeMessage() SubscribeMessage {
+	return SubscribeMessage{
 		MessageFields: MessageFields{
 			Type:      "subscribe",
 			Channel:   "orderbook",
-			RequestID: rID,
+			RequestID: wso.SubscribeRequestID,
 		},
 		Payload: SubscribePayload{
 			Snapshot:          true,
-			Limit:             limit,
+			Limit:             wso.limit,
 			BaseTokenAddress:  wso.BaseTokenAddress,
 			QuoteTokenAddress: wso.QuoteTokenAddress,
 		},
 	}
-	bsm, err := json.Marshal(sm)
-
-	log.Println("Subscribing by", string(bsm))
-	wso.WS = ws
-	wso.SubscribeRequestID = rID
-	err = wso.WS.WriteMessage(websocket.TextMessage, bsm)
-	if err != nil {
-		return err
-	}
-	return nil
 }
 
-func NewWSOrderbook(baseTA, quoteTA string, limit int) (*WSOrderbook, error) {
-	pair := fmt.Sprintf("%s/%s", A2T[baseTA], A2T[quoteTA])
-	log.Println("creating websocket for", pair)
+// addSub registers a subscription under the request id, c.mu must be held.
+func (c *WSClient) addSub(id int, baseTA, quoteTA string, limit int) *WSOrderbook {
+	// the relayer sends lowercase addresses
+	baseTA, quoteTA = strings.ToLower(baseTA), strings.ToLower(quoteTA)
 	wso := &WSOrderbook{
-		WS:                 nil,
 		BaseTokenAddress:   baseTA,
 		QuoteTokenAddress:  quoteTA,
-		Pair:               pair,
-		SubscribeRequestID: 0,
+		Pair:               fmt.Sprintf("%s/%s", c.tokens.Symbol(baseTA), c.tokens.Symbol(quoteTA)),
+		SubscribeRequestID: id,
+		limit:              limit,
+		lastMessage:        time.Now(),
+		book:               NewLocalBookWithTokens(baseTA, c.tokens),
+		client:             c,
 	}
-	err := wso.Subscribe(limit)
+	c.subs[id] = wso
+	return wso
+}
+
+// Subscribe subscribes to the orderbook channel of a token pair, asking
+// for a snapshot of up to limit orders per side.
+func (c *WSClient) Subscribe(baseTA, quoteTA string, limit int) (*WSOrderbook, error) {
+	ws, err := c.dial(context.Background())
 	if err != nil {
 		return nil, err
 	}
+	c.mu.Lock()
+	c.nextID++
+	wso := c.addSub(c.nextID, baseTA, quoteTA, limit)
+	c.mu.Unlock()
 
+	if err := c.writeJSON(ws, wso.subscribeMessage()); err != nil {
+		c.mu.Lock()
+		delete(c.subs, wso.SubscribeRequestID)
+		c.mu.Unlock()
+		return nil, err
+	}
 	return wso, nil
 }
 
+// Subscriptions returns the current subscriptions ordered by request id.
+func (c *WSClient) Subscriptions() []*WSOrderbook {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	subs := make([]*WSOrderbook, 0, len(c.subs))
+	for _, wso := range c.subs {
+		subs = append(subs, wso)
+	}
+	sort.Slice(subs, func(i, j int) bool {
+		return subs[i].SubscribeRequestID < subs[j].SubscribeRequestID
+	})
+	return subs
+}
+
+// Unsubscribe stops routing messages to wso and asks the relayer to stop
+// sending them.
+func (c *WSClient) Unsubscribe(wso *WSOrderbook) error {
+	c.mu.Lock()
+	_, ok := c.subs[wso.SubscribeRequestID]
+	delete(c.subs, wso.SubscribeRequestID)
+	ws := c.conn
+	c.mu.Unlock()
+	if !ok || ws == nil {
+		return nil
+	}
+	return c.writeJSON(ws, MessageFields{
+		Type:      "unsubscribe",
+		Channel:   "orderbook",
+		RequestID: wso.SubscribeRequestID,
+	})
+}
+
 type MessageFields struct {
 	Type      string `json:"type"`
 	Channel   string `json:"channel"`
@@ -139,69 +330,275 @@ type OfTheDayMessage struct {
 	Announcements []string `json:"announcements"`
 }
 
-func (wso *WSOrderbook) Run() {
+// Events returns the channel Run delivers events on. Consumers have to
+// keep reading it, Run blocks when it's full.
+func (c *WSClient) Events() <-chan Event {
+	return c.events
+}
+
+func (c *WSClient) emit(ctx context.Context, ev Event) {
+	select {
+	case c.events <- ev:
+	case <-ctx.Done():
+	case <-c.closing:
+	}
+}
+
+// Events returns the event channel of the underlying WSClient, shared by
+// all its subscriptions.
+func (wso *WSOrderbook) Events() <-chan Event {
+	return wso.client.Events()
+}
+
+// Run runs the underlying WSClient, see WSClient.Run.
+func (wso *WSOrderbook) Run(ctx context.Context) error {
+	return wso.client.Run(ctx)
+}
+
+// Close closes the underlying WSClient.
+func (wso *WSOrderbook) Close() error {
+	return wso.client.Close()
+}
+
+// BestBid returns the highest bid of the local book.
+func (wso *WSOrderbook) BestBid() (*BookOrder, bool) {
+	return wso.book.BestBid()
+}
+
+// BestAsk returns the lowest ask of the local book.
+func (wso *WSOrderbook) BestAsk() (*BookOrder, bool) {
+	return wso.book.BestAsk()
+}
+
+// Depth returns up to n best bids and asks of the local book.
+func (wso *WSOrderbook) Depth(n int) (bids, asks []BookOrder) {
+	return wso.book.Depth(n)
+}
+
+// Close sends a close frame to the server and stops Run, which then
+// returns nil. It waits up to a second for the server to confirm.
+func (c *WSClient) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		close(c.closing)
+		ws := c.ws()
+		if ws == nil {
+			return
+		}
+		msg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+		err = ws.WriteControl(websocket.CloseMessage, msg, time.Now().Add(closeTimeout))
+		select {
+		case <-c.stopped:
+		case <-time.After(closeTimeout):
+		}
+		c.closeWS()
+	})
+	return err
+}
+
+func (c *WSClient) isClosing() bool {
+	select {
+	case <-c.closing:
+		return true
+	default:
+		return false
+	}
+}
+
+// Run reads the websocket, keeps the local books of the subscriptions up
+// to date and sends events on the Events channel. Malformed messages are
+// reported as ErrorEvents. Run returns nil after Close, ctx.Err() when ctx
+// is cancelled, or the error which made reconnecting fail. Events is
+// closed when Run returns, so Run can only be called once.
+func (c *WSClient) Run(ctx context.Context) error {
+	defer close(c.events)
+	defer close(c.stopped)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			// unblocks ReadMessage
+			c.closeWS()
+		case <-done:
+		}
+	}()
+	go c.ping(done)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		c.watchStale(done)
+	}()
 
 	for {
-		_, msg, err := wso.WS.ReadMessage()
-		if err != nil {
-			log.Println("ERROR:", err)
-			if websocket.IsCloseError(err, websocketErrs...) {
-				log.Println(wso.Pair, "re-opening Websocket")
-				wso.WS.Close()
-				err := wso.Subscribe(snapshotLimit)
-				if err != nil {
-					log.Fatal(err)
-				}
-				log.Println(wso.Pair, "Websocket re-opened")
-			} else {
-				log.Fatal(err)
-			}
-			continue
-		}
-		mtype, _ := jsonparser.GetUnsafeString(msg, "type")
-		switch mtype {
-		case "subscribe":
-			sm := SubscribeMessage{}
-			err := json.Unmarshal(msg, &sm)
-			if err != nil {
-				log.Fatal(err)
-			}
-			log.Println(sm)
-		case "snapshot":
-			snm := SnapshotMessage{}
-			err := json.Unmarshal(msg, &snm)
-			if err != nil {
-				log.Fatal()
-			}
-			snm.Payload.Reverse()
-			log.Println(snm.Payload)
-		case "update":
-			um := UpdateMessage{}
-			err := json.Unmarshal(msg, &um)
-			if err != nil {
-				log.Fatal()
-			}
-			bidAsk := "Bid"
-			if um.Payload.MakerToken == wso.BaseTokenAddress {
-				bidAsk = "Ask"
-			}
-			s, _ := um.Payload.Process(bidAsk)
-			log.Printf("New %s: %s\n", bidAsk, s)
-		default:
-			motd := OfTheDayMessage{Announcements: []string{}}
-			err := json.Unmarshal(msg, &motd)
-			if err != nil {
-				log.Println("ERROR WS receivedi garbage", string(msg))
-			} else {
-				log.Println("MOTD:", motd.MOTD)
-				if len(motd.Announcements) > 0 {
-					for _, a := range motd.Announcements {
-						log.Println("*", a)
-					}
+		ws, err := c.dial(ctx)
+		if err == nil {
+			var msg []byte
+			_, msg, err = ws.ReadMessage()
+			if err == nil {
+				c.extendDeadline(ws)
+				c.Recorder.record(false, msg)
+				c.count(func(s *WSStats) {
+					s.Messages++
+					s.LastMessage = time.Now()
+				})
+				if err := c.handleMessage(ctx, msg); err != nil {
+					c.emit(ctx, ErrorEvent{Err: err})
 				}
+				continue
 			}
 		}
+		if c.isClosing() {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := c.reconnect(ctx, err); err != nil {
+			return err
+		}
+	}
+}
 
+// resubscribe opens a new connection and replays all subscriptions with
+// their original parameters. The new snapshots reset the local books.
+func (c *WSClient) resubscribe(ctx context.Context) error {
+	c.mu.Lock()
+	if c.conn != nil {
+		c.conn.Close()
+		c.conn = nil
+	}
+	c.mu.Unlock()
+	ws, err := c.dial(ctx)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	subs := make([]*WSOrderbook, 0, len(c.subs))
+	for _, wso := range c.subs {
+		subs = append(subs, wso)
+	}
+	c.mu.Unlock()
+	for _, wso := range subs {
+		if err := c.writeJSON(ws, wso.subscribeMessage()); err != nil {
+			return err
+		}
 	}
-	log.Println("Shouldnt be here")
+	return nil
+}
+
+// reconnect re-opens the websocket according to the reconnect policy. cause
+// is the error which broke the connection.
+func (c *WSClient) reconnect(ctx context.Context, cause error) error {
+	p := c.Reconnect
+	for attempt := 1; p.MaxAttempts == 0 || attempt <= p.MaxAttempts; attempt++ {
+		select {
+		case <-time.After(p.delay(attempt)):
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-c.closing:
+			return nil
+		}
+		err := c.resubscribe(ctx)
+		if err == nil {
+			c.count(func(s *WSStats) { s.Reconnects++ })
+			c.emit(ctx, ReconnectedEvent{Attempts: attempt, Cause: cause})
+			return nil
+		}
+		if c.isClosing() {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		c.emit(ctx, ErrorEvent{Err: err})
+	}
+	return fmt.Errorf("giving up reconnecting after %d attempts, connection broke by: %s", p.MaxAttempts, cause)
+}
+
+// handleMessage routes a received message to its subscription by the
+// requestId, or handles it on the client level if it has none.
+func (c *WSClient) handleMessage(ctx context.Context, msg []byte) error {
+	mtype, _ := jsonparser.GetUnsafeString(msg, "type")
+	switch mtype {
+	case "subscribe", "snapshot", "update":
+		rID, err := jsonparser.GetInt(msg, "requestId")
+		if err != nil {
+			return fmt.Errorf("%s message without requestId", mtype)
+		}
+		c.mu.Lock()
+		wso, ok := c.subs[int(rID)]
+		if ok {
+			wso.lastMessage = time.Now()
+		}
+		c.mu.Unlock()
+		if !ok {
+			// left over from a cancelled subscription
+			return nil
+		}
+		if err := wso.handleMessage(ctx, mtype, msg); err != nil {
+			c.emit(ctx, ErrorEvent{Pair: wso.Pair, Err: err})
+		}
+	default:
+		motd := OfTheDayMessage{Announcements: []string{}}
+		err := json.Unmarshal(msg, &motd)
+		if err != nil || (motd.MOTD == "" && len(motd.Announcements) == 0) {
+			return fmt.Errorf("received garbage %q", msg)
+		}
+		c.emit(ctx, MOTDEvent{MOTD: motd.MOTD, Announcements: motd.Announcements})
+	}
+	return nil
+}
+
+// handleMessage applies a message of the subscription to the local book and
+// emits the resulting events.
+func (wso *WSOrderbook) handleMessage(ctx context.Context, mtype string, msg []byte) error {
+	c := wso.client
+	switch mtype {
+	case "subscribe":
+		sm := SubscribeMessage{}
+		if err := json.Unmarshal(msg, &sm); err != nil {
+			return fmt.Errorf("malformed subscribe message: %s", err)
+		}
+	case "snapshot":
+		snm := SnapshotMessage{}
+		if err := json.Unmarshal(msg, &snm); err != nil {
+			return fmt.Errorf("malformed snapshot message: %s", err)
+		}
+		if snm.Payload == nil {
+			return fmt.Errorf("snapshot message without payload")
+		}
+		snm.Payload.Reverse()
+		if err := wso.book.Reset(snm.Payload); err != nil {
+			return err
+		}
+		c.emit(ctx, SnapshotEvent{Pair: wso.Pair, Orderbook: snm.Payload})
+	case "update":
+		um := UpdateMessage{}
+		if err := json.Unmarshal(msg, &um); err != nil {
+			return fmt.Errorf("malformed update message: %s", err)
+		}
+		if um.Payload == nil {
+			return fmt.Errorf("update message without payload")
+		}
+		// Fill state isn't part of the 0x order schema, pick it up
+		// if the relayer sends it along.
+		um.Payload.TakerTokenAmountFilled, _ = jsonparser.GetString(msg, "payload", "takerTokenAmountFilled")
+		um.Payload.TakerTokenAmountCancelled, _ = jsonparser.GetString(msg, "payload", "takerTokenAmountCancelled")
+		bo, removed, err := wso.book.Update(*um.Payload)
+		if err != nil {
+			return err
+		}
+		switch {
+		case bo == nil:
+			// removal of an order which wasn't in the book
+		case removed:
+			c.emit(ctx, OrderRemovedEvent{Pair: wso.Pair, Order: *um.Payload, BookOrder: bo})
+		default:
+			c.emit(ctx, OrderAddedEvent{Pair: wso.Pair, Order: *um.Payload, BookOrder: bo})
+		}
+	}
+	return nil
 }
@@ -0,0 +1,617 @@
+package rrgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// wsServer is a local orderbook feed. serve gets the connection after the
+// subscribe message was read, and the subscribe message itself.
+func wsServer(t *testing.T, serve func(*websocket.Conn, SubscribeMessage)) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer c.Close()
+		sm := SubscribeMessage{}
+		if err := c.ReadJSON(&sm); err != nil {
+			return
+		}
+		serve(c, sm)
+	}))
+}
+
+func wsURL(srv *httptest.Server) string {
+	return "ws" + strings.TrimPrefix(srv.URL, "http")
+}
+
+func TestWSGarbageAndClose(t *testing.T) {
+	gotClose := make(chan struct{})
+	exp := time.Now().Add(time.Hour)
+	srv := wsServer(t, func(c *websocket.Conn, sm SubscribeMessage) {
+		c.WriteMessage(websocket.TextMessage, []byte("garbage"))
+		c.WriteMessage(websocket.TextMessage, []byte(`{"type": "update", "payload": 5}`))
+		c.WriteMessage(websocket.TextMessage, []byte(`{"type": "snapshot"}`))
+		snm := SnapshotMessage{
+			MessageFields: MessageFields{Type: "snapshot", Channel: "orderbook", RequestID: sm.RequestID},
+			Payload: &Orderbook{
+				Asks: []APIOrder{testBookOrder(t, true, 100, 2, exp)},
+				Bids: []APIOrder{testBookOrder(t, false, 100, 1, exp)},
+			},
+		}
+		bs, _ := json.Marshal(snm)
+		c.WriteMessage(websocket.TextMessage, bs)
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+					close(gotClose)
+				}
+				return
+			}
+		}
+	})
+	defer srv.Close()
+
+	wso, err := newWSClient(wsURL(srv)).Subscribe(T2A["ZRX"], T2A["WETH"], snapshotLimit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runErr := make(chan error)
+	go func() {
+		runErr <- wso.Run(context.Background())
+	}()
+
+	errs := 0
+	for ev := range wso.Events() {
+		if _, ok := ev.(ErrorEvent); ok {
+			errs++
+			continue
+		}
+		if _, ok := ev.(SnapshotEvent); !ok {
+			t.Fatalf("unexpected event %#v", ev)
+		}
+		break
+	}
+	if errs != 3 {
+		t.Fatalf("got %d error events, want 3", errs)
+	}
+	if bid, ok := wso.BestBid(); !ok || bid.PriceFloat() != 0.01 {
+		t.Fatalf("wrong best bid %v", bid)
+	}
+
+	if err := wso.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-runErr; err != nil {
+		t.Fatalf("Run returned %s after Close", err)
+	}
+	select {
+	case <-gotClose:
+	case <-time.After(time.Second):
+		t.Fatal("server didn't get a close frame")
+	}
+}
+
+func TestWSRunContext(t *testing.T) {
+	srv := wsServer(t, func(c *websocket.Conn, sm SubscribeMessage) {
+		c.ReadMessage()
+	})
+	defer srv.Close()
+
+	wso, err := newWSClient(wsURL(srv)).Subscribe(T2A["ZRX"], T2A["WETH"], snapshotLimit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := wso.Run(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("Run returned %v, expected deadline exceeded", err)
+	}
+}
+
+func TestWSReconnect(t *testing.T) {
+	exp := time.Now().Add(time.Hour)
+	var conns int32
+	srv := wsServer(t, func(c *websocket.Conn, sm SubscribeMessage) {
+		n := atomic.AddInt32(&conns, 1)
+		if sm.Payload.Limit != 7 {
+			t.Errorf("subscribed with limit %d, want 7", sm.Payload.Limit)
+		}
+		price := int64(n)
+		snm := SnapshotMessage{
+			MessageFields: MessageFields{Type: "snapshot", Channel: "orderbook", RequestID: sm.RequestID},
+			Payload:       &Orderbook{Bids: []APIOrder{testBookOrder(t, false, 100, price, exp)}},
+		}
+		bs, _ := json.Marshal(snm)
+		c.WriteMessage(websocket.TextMessage, bs)
+		if n == 1 {
+			// drop the connection without a close frame
+			return
+		}
+		c.ReadMessage()
+	})
+	defer srv.Close()
+
+	c := newWSClient(wsURL(srv))
+	c.Reconnect = ReconnectPolicy{InitialDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond, MaxAttempts: 3}
+	wso, err := c.Subscribe(T2A["ZRX"], T2A["WETH"], 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go wso.Run(context.Background())
+	defer wso.Close()
+
+	kinds := []string{}
+	for ev := range wso.Events() {
+		switch ev.(type) {
+		case SnapshotEvent:
+			kinds = append(kinds, "snapshot")
+		case ReconnectedEvent:
+			kinds = append(kinds, "reconnected")
+		default:
+			t.Fatalf("unexpected event %#v", ev)
+		}
+		if len(kinds) == 3 {
+			break
+		}
+	}
+	if strings.Join(kinds, " ") != "snapshot reconnected snapshot" {
+		t.Fatalf("unexpected events %v", kinds)
+	}
+	if bid, _ := wso.BestBid(); bid.PriceFloat() != 0.02 {
+		t.Fatalf("book not resynchronized, best bid %v", bid)
+	}
+}
+
+func TestWSReconnectGiveUp(t *testing.T) {
+	srv := wsServer(t, func(c *websocket.Conn, sm SubscribeMessage) {})
+	c := newWSClient(wsURL(srv))
+	c.Reconnect = ReconnectPolicy{InitialDelay: 10 * time.Millisecond, MaxDelay: 10 * time.Millisecond, MaxAttempts: 2}
+	if _, err := c.Subscribe(T2A["ZRX"], T2A["WETH"], snapshotLimit); err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+	go func() {
+		for range c.Events() {
+		}
+	}()
+	if err := c.Run(context.Background()); err == nil {
+		t.Fatal("Run should give up when the server is gone")
+	}
+}
+
+// TestWSReconnectCancel cancels Run while reconnecting hangs in the
+// handshake, which must neither hold up Run nor the subscription methods.
+func TestWSReconnectCancel(t *testing.T) {
+	var conns int32
+	handshake := make(chan struct{})
+	release := make(chan struct{})
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&conns, 1) {
+		case 1:
+			c, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			// drop the connection after the subscription
+			c.ReadMessage()
+			c.Close()
+			return
+		case 2:
+			close(handshake)
+		}
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	c := newWSClient(wsURL(srv))
+	c.Reconnect = ReconnectPolicy{InitialDelay: 10 * time.Millisecond, MaxDelay: 10 * time.Millisecond}
+	c.dialer.HandshakeTimeout = 10 * time.Second
+	if _, err := c.Subscribe(T2A["ZRX"], T2A["WETH"], snapshotLimit); err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for range c.Events() {
+		}
+	}()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- c.Run(ctx) }()
+
+	<-handshake
+	subs := make(chan int, 1)
+	go func() { subs <- len(c.Subscriptions()) }()
+	select {
+	case n := <-subs:
+		if n != 1 {
+			t.Fatalf("%d subscriptions, want 1", n)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Subscriptions blocked by the handshake")
+	}
+	cancel()
+	select {
+	case err := <-done:
+		if err != context.Canceled {
+			t.Fatalf("Run returned %v, want context.Canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("cancelling Run waited for the handshake")
+	}
+}
+
+func TestReconnectDelay(t *testing.T) {
+	p := ReconnectPolicy{InitialDelay: time.Second, MaxDelay: 5 * time.Second}
+	for i, want := range []time.Duration{1, 2, 4, 5, 5} {
+		if d := p.delay(i + 1); d != want*time.Second {
+			t.Fatalf("attempt %d: delay %s, want %s", i+1, d, want*time.Second)
+		}
+	}
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if d := p.delay(1); d < 500*time.Millisecond || d > 1500*time.Millisecond {
+			t.Fatalf("jittered delay %s out of range", d)
+		}
+	}
+
+	// zero delays are the defaults, not a busy loop
+	p = ReconnectPolicy{MaxAttempts: 5}
+	if d := p.delay(1); d != DefaultReconnectPolicy.InitialDelay {
+		t.Fatalf("zero policy: first delay %s", d)
+	}
+	if d := p.delay(20); d != DefaultReconnectPolicy.MaxDelay {
+		t.Fatalf("zero policy: delay %s not capped", d)
+	}
+	p = ReconnectPolicy{MaxDelay: 100 * time.Millisecond}
+	if d := p.delay(1); d != 100*time.Millisecond {
+		t.Fatalf("initial delay %s above MaxDelay", d)
+	}
+}
+
+func TestWSClientMultiplex(t *testing.T) {
+	exp := time.Now().Add(time.Hour)
+	srv := wsServer(t, func(c *websocket.Conn, sm SubscribeMessage) {
+		for {
+			switch sm.Type {
+			case "subscribe":
+				// price the book by request id to tell the pairs apart
+				snm := SnapshotMessage{
+					MessageFields: MessageFields{Type: "snapshot", Channel: "orderbook", RequestID: sm.RequestID},
+					Payload: &Orderbook{Bids: []APIOrder{
+						testBookOrder(t, false, 100, int64(sm.RequestID), exp),
+					}},
+				}
+				bs, _ := json.Marshal(snm)
+				c.WriteMessage(websocket.TextMessage, bs)
+			case "unsubscribe":
+				c.WriteMessage(websocket.TextMessage, []byte(`{"motd": "unsubscribed"}`))
+			}
+			sm = SubscribeMessage{}
+			if err := c.ReadJSON(&sm); err != nil {
+				return
+			}
+		}
+	})
+	defer srv.Close()
+
+	c := newWSClient(wsURL(srv))
+	books := []*WSOrderbook{}
+	for _, pair := range [][2]string{{"ZRX", "WETH"}, {"MKR", "WETH"}, {"DGD", "WETH"}} {
+		wso, err := c.Subscribe(T2A[pair[0]], T2A[pair[1]], snapshotLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		books = append(books, wso)
+	}
+	go c.Run(context.Background())
+	defer c.Close()
+
+	pairs := map[string]bool{}
+	for ev := range c.Events() {
+		sev, ok := ev.(SnapshotEvent)
+		if !ok {
+			t.Fatalf("unexpected event %#v", ev)
+		}
+		pairs[sev.Pair] = true
+		if len(pairs) == len(books) {
+			break
+		}
+	}
+	for i, wso := range books {
+		if wso.SubscribeRequestID != i+1 {
+			t.Fatalf("%s has request id %d, want %d", wso.Pair, wso.SubscribeRequestID, i+1)
+		}
+		if bid, ok := wso.BestBid(); !ok || bid.PriceFloat() != float64(i+1)/100 {
+			t.Fatalf("%s got the wrong snapshot, best bid %v", wso.Pair, bid)
+		}
+	}
+
+	if err := c.Unsubscribe(books[0]); err != nil {
+		t.Fatal(err)
+	}
+	if ev := <-c.Events(); ev.(MOTDEvent).MOTD != "unsubscribed" {
+		t.Fatalf("unexpected event %#v", ev)
+	}
+}
+
+func TestWSHeartbeat(t *testing.T) {
+	var conns int32
+	srv := wsServer(t, func(c *websocket.Conn, sm SubscribeMessage) {
+		if atomic.AddInt32(&conns, 1) == 1 {
+			// never read, so pings go unanswered
+			time.Sleep(time.Second)
+			return
+		}
+		// reading answers pings
+		c.ReadMessage()
+	})
+	defer srv.Close()
+
+	c := newWSClient(wsURL(srv))
+	c.Reconnect = ReconnectPolicy{InitialDelay: 10 * time.Millisecond, MaxDelay: 10 * time.Millisecond}
+	c.Heartbeat = Heartbeat{PingInterval: 20 * time.Millisecond, ReadTimeout: 100 * time.Millisecond}
+	if _, err := c.Subscribe(T2A["ZRX"], T2A["WETH"], snapshotLimit); err != nil {
+		t.Fatal(err)
+	}
+	go c.Run(context.Background())
+	defer c.Close()
+
+	ev := <-c.Events()
+	rev, ok := ev.(ReconnectedEvent)
+	if !ok {
+		t.Fatalf("unexpected event %#v", ev)
+	}
+	if ne, ok := rev.Cause.(interface{ Timeout() bool }); !ok || !ne.Timeout() {
+		t.Fatalf("reconnected because of %v, want a timeout", rev.Cause)
+	}
+	select {
+	case ev := <-c.Events():
+		t.Fatalf("unexpected event %#v", ev)
+	case <-time.After(300 * time.Millisecond):
+	}
+	s := c.Stats()
+	if s.Reconnects != 1 || s.PingsSent == 0 || s.PongsReceived == 0 {
+		t.Fatalf("unexpected stats %+v", s)
+	}
+}
+
+func TestWSStale(t *testing.T) {
+	exp := time.Now().Add(time.Hour)
+	srv := wsServer(t, func(c *websocket.Conn, sm SubscribeMessage) {
+		for {
+			snm := SnapshotMessage{
+				MessageFields: MessageFields{Type: "snapshot", Channel: "orderbook", RequestID: sm.RequestID},
+				Payload:       &Orderbook{Bids: []APIOrder{testBookOrder(t, false, 100, 1, exp)}},
+			}
+			bs, _ := json.Marshal(snm)
+			c.WriteMessage(websocket.TextMessage, bs)
+			if err := c.ReadJSON(&sm); err != nil {
+				return
+			}
+		}
+	})
+	defer srv.Close()
+
+	c := newWSClient(wsURL(srv))
+	c.Heartbeat = Heartbeat{StaleAfter: 50 * time.Millisecond}
+	if _, err := c.Subscribe(T2A["ZRX"], T2A["WETH"], snapshotLimit); err != nil {
+		t.Fatal(err)
+	}
+	go c.Run(context.Background())
+	defer c.Close()
+
+	kinds := []string{}
+	for ev := range c.Events() {
+		switch ev.(type) {
+		case SnapshotEvent:
+			kinds = append(kinds, "snapshot")
+		case StaleEvent:
+			kinds = append(kinds, "stale")
+		default:
+			t.Fatalf("unexpected event %#v", ev)
+		}
+		if len(kinds) == 3 {
+			break
+		}
+	}
+	if strings.Join(kinds, " ") != "snapshot stale snapshot" {
+		t.Fatalf("unexpected events %v", kinds)
+	}
+	if s := c.Stats(); s.StaleResnapshots != 1 {
+		t.Fatalf("unexpected stats %+v", s)
+	}
+}
+
+// eventKinds reads n events and names their types.
+func eventKinds(t *testing.T, events <-chan Event, n int) string {
+	kinds := []string{}
+	for ev := range events {
+		switch ev := ev.(type) {
+		case SnapshotEvent:
+			kinds = append(kinds, "snapshot")
+		case OrderAddedEvent:
+			kinds = append(kinds, "added")
+		case MOTDEvent:
+			kinds = append(kinds, "motd")
+		case ErrorEvent:
+			kinds = append(kinds, "error")
+		default:
+			t.Fatalf("unexpected event %#v", ev)
+		}
+		if len(kinds) == n {
+			break
+		}
+	}
+	return strings.Join(kinds, " ")
+}
+
+func TestWSRecordReplay(t *testing.T) {
+	exp := time.Now().Add(time.Hour)
+	srv := wsServer(t, func(c *websocket.Conn, sm SubscribeMessage) {
+		snm := SnapshotMessage{
+			MessageFields: MessageFields{Type: "snapshot", Channel: "orderbook", RequestID: sm.RequestID},
+			Payload:       &Orderbook{Bids: []APIOrder{testBookOrder(t, false, 100, 1, exp)}},
+		}
+		bs, _ := json.Marshal(snm)
+		c.WriteMessage(websocket.TextMessage, bs)
+		o := testBookOrder(t, false, 100, 2, exp)
+		um := UpdateMessage{
+			MessageFields: MessageFields{Type: "update", Channel: "orderbook", RequestID: sm.RequestID},
+			Payload:       &o,
+		}
+		bs, _ = json.Marshal(um)
+		c.WriteMessage(websocket.TextMessage, bs)
+		c.WriteMessage(websocket.TextMessage, []byte("garbage"))
+		c.WriteMessage(websocket.TextMessage, []byte(`{"motd": "hello"}`))
+		c.ReadMessage()
+	})
+	defer srv.Close()
+
+	rec := &bytes.Buffer{}
+	c := newWSClient(wsURL(srv))
+	c.Recorder = NewRecorder(rec)
+	if _, err := c.Subscribe(T2A["ZRX"], T2A["WETH"], snapshotLimit); err != nil {
+		t.Fatal(err)
+	}
+	go c.Run(context.Background())
+	want := "snapshot added error motd"
+	if kinds := eventKinds(t, c.Events(), 4); kinds != want {
+		t.Fatalf("live events %q, want %q", kinds, want)
+	}
+	c.Close()
+	if err := c.Recorder.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(rec.String(), "\n"); n != 5 {
+		t.Fatalf("recorded %d frames, want 5:\n%s", n, rec)
+	}
+
+	rc := newWSClient("")
+	replayErr := make(chan error, 1)
+	go func() {
+		replayErr <- rc.Replay(context.Background(), rec, 0)
+	}()
+	if kinds := eventKinds(t, rc.Events(), 4); kinds != want {
+		t.Fatalf("replayed events %q, want %q", kinds, want)
+	}
+	if _, ok := <-rc.Events(); ok {
+		t.Fatal("events not closed at the end of the recording")
+	}
+	if err := <-replayErr; err != nil {
+		t.Fatal(err)
+	}
+	subs := rc.Subscriptions()
+	if len(subs) != 1 || subs[0].Pair != "ZRX/WETH" {
+		t.Fatalf("unexpected subscriptions %v", subs)
+	}
+	if bid, ok := subs[0].BestBid(); !ok || bid.PriceFloat() != 0.02 {
+		t.Fatalf("wrong best bid %v", bid)
+	}
+}
+
+func TestWSReplaySpeed(t *testing.T) {
+	t0 := time.Now()
+	rec := &bytes.Buffer{}
+	enc := json.NewEncoder(rec)
+	enc.Encode(RecordedFrame{Time: t0, Frame: `{"motd": "one"}`})
+	enc.Encode(RecordedFrame{Time: t0.Add(400 * time.Millisecond), Frame: `{"motd": "two"}`})
+
+	c := newWSClient("")
+	go c.Replay(context.Background(), rec, 2)
+	start := time.Now()
+	if kinds := eventKinds(t, c.Events(), 2); kinds != "motd motd" {
+		t.Fatalf("unexpected events %q", kinds)
+	}
+	if d := time.Since(start); d < 200*time.Millisecond || d > 390*time.Millisecond {
+		t.Fatalf("replay at double speed took %s, want 200ms", d)
+	}
+}
+
+func TestWSTokens(t *testing.T) {
+	exp := time.Now().Add(time.Hour)
+	srv := wsServer(t, func(c *websocket.Conn, sm SubscribeMessage) {
+		snm := SnapshotMessage{
+			MessageFields: MessageFields{Type: "snapshot", Channel: "orderbook", RequestID: sm.RequestID},
+			Payload:       &Orderbook{Asks: []APIOrder{testBookOrder(t, true, 100, 2, exp)}},
+		}
+		bs, _ := json.Marshal(snm)
+		c.WriteMessage(websocket.TextMessage, bs)
+		c.ReadMessage()
+	})
+	defer srv.Close()
+
+	// ZRX and WETH renamed and with 2 decimals
+	r := NewTokenRegistry(NetworkMainnet)
+	r.Add(TokenInfo{Address: T2A["ZRX"], Symbol: "Z", Decimals: 2})
+	r.Add(TokenInfo{Address: T2A["WETH"], Symbol: "W", Decimals: 2})
+	c := NewWSClient(WithWSURL(wsURL(srv)), WithWSTokens(r))
+	wso, err := c.Subscribe(T2A["ZRX"], T2A["WETH"], snapshotLimit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go c.Run(context.Background())
+	defer c.Close()
+	if wso.Pair != "Z/W" {
+		t.Fatalf("pair %s not named from the registry", wso.Pair)
+	}
+	if kinds := eventKinds(t, c.Events(), 1); kinds != "snapshot" {
+		t.Fatalf("unexpected events %s", kinds)
+	}
+	if ask, ok := wso.BestAsk(); !ok || ask.Pair != "Z/W" || ask.VolumeString() != "1" {
+		t.Fatalf("ask %v not scaled by the registry", ask)
+	}
+}
+
+func TestWSOptions(t *testing.T) {
+	reqs := make(chan *http.Request, 1)
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqs <- r
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		c.ReadMessage()
+	}))
+	defer srv.Close()
+
+	os.Setenv(wsEndpointEnvVar, "ws://127.0.0.1:1/unused")
+	defer os.Unsetenv(wsEndpointEnvVar)
+	if c := NewWSClient(); c.url != "ws://127.0.0.1:1/unused" {
+		t.Fatalf("%s ignored, url is %s", wsEndpointEnvVar, c.url)
+	}
+
+	c := NewWSClient(
+		WithWSURL(wsURL(srv)),
+		WithWSHeader(http.Header{"X-Api-Key": {"secret"}}),
+		WithWSCompression(true),
+		WithWSHandshakeTimeout(time.Second),
+	)
+	if _, err := c.Subscribe(T2A["ZRX"], T2A["WETH"], snapshotLimit); err != nil {
+		t.Fatal(err)
+	}
+	defer c.closeWS()
+	r := <-reqs
+	if r.Header.Get("X-Api-Key") != "secret" {
+		t.Fatalf("custom header not sent: %v", r.Header)
+	}
+	if !strings.Contains(r.Header.Get("Sec-Websocket-Extensions"), "permessage-deflate") {
+		t.Fatalf("compression not offered: %v", r.Header)
+	}
+}
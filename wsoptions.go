@@ -0,0 +1,73 @@
+package rrgo
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// WSOption configures a WSClient created by NewWSClient.
+type WSOption func(*WSClient)
+
+// WithWSURL connects to the websocket endpoint u, overriding WSURL and
+// RRGO_WS_URL.
+func WithWSURL(u string) WSOption {
+	return func(c *WSClient) {
+		c.url = u
+	}
+}
+
+// WithWSHeader adds headers to the handshake request, like an API key or
+// an Origin required by the relayer.
+func WithWSHeader(h http.Header) WSOption {
+	return func(c *WSClient) {
+		if c.header == nil {
+			c.header = http.Header{}
+		}
+		for k, vs := range h {
+			for _, v := range vs {
+				c.header.Add(k, v)
+			}
+		}
+	}
+}
+
+// WithWSProxy sets the function choosing a proxy for the handshake
+// request. The default uses the HTTP(S)_PROXY environment variables, a
+// nil proxy connects directly.
+func WithWSProxy(proxy func(*http.Request) (*url.URL, error)) WSOption {
+	return func(c *WSClient) {
+		c.dialer.Proxy = proxy
+	}
+}
+
+// WithWSTLSConfig sets the TLS configuration of wss:// connections.
+func WithWSTLSConfig(cfg *tls.Config) WSOption {
+	return func(c *WSClient) {
+		c.dialer.TLSClientConfig = cfg
+	}
+}
+
+// WithWSCompression asks the server for per-message compression.
+func WithWSCompression(enable bool) WSOption {
+	return func(c *WSClient) {
+		c.dialer.EnableCompression = enable
+	}
+}
+
+// WithWSHandshakeTimeout limits the time to open a connection, 5 seconds
+// by default.
+func WithWSHandshakeTimeout(d time.Duration) WSOption {
+	return func(c *WSClient) {
+		c.dialer.HandshakeTimeout = d
+	}
+}
+
+// WithWSTokens looks up the tokens of the subscribed pairs and their orders
+// in r instead of DefaultTokens, like for a network other than mainnet.
+func WithWSTokens(r *TokenRegistry) WSOption {
+	return func(c *WSClient) {
+		c.tokens = r
+	}
+}